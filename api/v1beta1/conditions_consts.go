@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+const (
+	// DiskEncryptionKeysUpToDateCondition reports whether the disks of the instance are encrypted with the
+	// Cloud KMS key versions pinned in the GCPMachine spec, or with the primary versions of unpinned keys.
+	DiskEncryptionKeysUpToDateCondition clusterv1.ConditionType = "DiskEncryptionKeysUpToDate"
+
+	// DiskEncryptionKeyVersionMismatchReason used when a disk is encrypted with a different key version than
+	// the pinned or primary one, e.g. after the key was rotated.
+	DiskEncryptionKeyVersionMismatchReason = "DiskEncryptionKeyVersionMismatch"
+
+	// DiskEncryptionKeyVersionUnknownReason used when the primary version of an unpinned key cannot be read from
+	// Cloud KMS, e.g. when the cloudkms.cryptoKeys.get permission is missing.
+	DiskEncryptionKeyVersionUnknownReason = "DiskEncryptionKeyVersionUnknown"
+
+	// PreflightChecksSucceededCondition reports whether the GCPMachine passed the checks run against the Compute API
+	// before its instance is created, e.g. that the machine type and disk types are available in its zone.
+	PreflightChecksSucceededCondition clusterv1.ConditionType = "PreflightChecksSucceeded"
+
+	// PreflightChecksFailedReason used when the instance of the GCPMachine cannot be created as specified in its
+	// project and zone.
+	PreflightChecksFailedReason = "PreflightChecksFailed"
+)
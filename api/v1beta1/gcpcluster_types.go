@@ -82,6 +82,10 @@ type GCPClusterStatus struct {
 
 	// Bastion Instance `json:"bastion,omitempty"`
 	Ready bool `json:"ready"`
+
+	// Conditions defines current service state of the GCPCluster.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -102,6 +106,16 @@ type GCPCluster struct {
 	Status GCPClusterStatus `json:"status,omitempty"`
 }
 
+// GetConditions returns the observations of the operational state of the GCPCluster resource.
+func (r *GCPCluster) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the GCPCluster to the predescribed clusterv1.Conditions.
+func (r *GCPCluster) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 
 // GCPClusterList contains a list of GCPCluster.
@@ -17,8 +17,12 @@ limitations under the License.
 package v1beta1
 
 import (
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/strings/slices"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 const (
@@ -37,6 +41,33 @@ const (
 	PdSsdDiskType DiskType = "pd-ssd"
 	// LocalSsdDiskType defines the name for the local ssd disk.
 	LocalSsdDiskType DiskType = "local-ssd"
+	// PdBalancedDiskType defines the name for the balanced disk.
+	PdBalancedDiskType DiskType = "pd-balanced"
+	// PdExtremeDiskType defines the name for the extreme disk.
+	PdExtremeDiskType DiskType = "pd-extreme"
+	// HyperdiskBalancedDiskType defines the name for the hyperdisk balanced disk.
+	HyperdiskBalancedDiskType DiskType = "hyperdisk-balanced"
+	// HyperdiskExtremeDiskType defines the name for the hyperdisk extreme disk.
+	HyperdiskExtremeDiskType DiskType = "hyperdisk-extreme"
+	// HyperdiskThroughputDiskType defines the name for the hyperdisk throughput disk.
+	HyperdiskThroughputDiskType DiskType = "hyperdisk-throughput"
+)
+
+// Provisioned performance can only be configured on some disk types.
+// reference: https://cloud.google.com/compute/docs/disks/hyperdisks
+var (
+	diskTypesSupportingProvisionedIops       = []string{string(PdExtremeDiskType), string(HyperdiskBalancedDiskType), string(HyperdiskExtremeDiskType)}
+	diskTypesSupportingProvisionedThroughput = []string{string(HyperdiskBalancedDiskType), string(HyperdiskThroughputDiskType)}
+)
+
+// DiskRetainPolicy defines what happens to an attached disk when its instance is deleted.
+type DiskRetainPolicy string
+
+const (
+	// DiskRetainPolicyDelete deletes the disk together with the instance.
+	DiskRetainPolicyDelete DiskRetainPolicy = "Delete"
+	// DiskRetainPolicyRetain detaches the disk from the instance and keeps it when the instance is deleted.
+	DiskRetainPolicyRetain DiskRetainPolicy = "Retain"
 )
 
 // AttachedDiskSpec degined GCP machine disk.
@@ -47,19 +78,98 @@ type AttachedDiskSpec struct {
 	// 2. "pd-ssd" - SSD persistent disk
 	// 3. "local-ssd" - Local SSD disk (https://cloud.google.com/compute/docs/disks/local-ssd).
 	// 4. "pd-balanced" - Balanced Persistent Disk
-	// 5. "hyperdisk-balanced" - Hyperdisk Balanced
+	// 5. "pd-extreme" - Extreme Persistent Disk
+	// 6. "hyperdisk-balanced" - Hyperdisk Balanced
+	// 7. "hyperdisk-extreme" - Hyperdisk Extreme
+	// 8. "hyperdisk-throughput" - Hyperdisk Throughput
 	// Default is "pd-standard".
 	// +optional
 	DeviceType *DiskType `json:"deviceType,omitempty"`
+	// Name is the name of the disk. When set and a disk with this name already exists in the zone
+	// of the machine, the existing disk is attached instead of creating a new one. Together with a
+	// RetainPolicy of Retain this allows a disk to be re-attached to a replacement machine.
+	// Not supported for "local-ssd" disks, nor in a GCPMachineTemplate, as every machine created
+	// from the template would share the disk. Use NameSuffix instead.
+	// Cannot be set together with NameSuffix.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// NameSuffix names the disk after the machine, as "<machine name>-<name suffix>", and otherwise behaves
+	// like Name: with a RetainPolicy of Retain, a GCPMachine re-created under the same name re-attaches the disk.
+	// Unlike Name it is supported in a GCPMachineTemplate, as every machine gets its own disk, but not together
+	// with a RetainPolicy of Retain there: machines created from a template are replaced under new names, so
+	// every replacement would leave an orphaned disk behind.
+	// The suffix must be an RFC 1035 label, and the machine name and the suffix must fit in 63 characters.
+	// Not supported for "local-ssd" disks. Cannot be set together with Name.
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	NameSuffix *string `json:"nameSuffix,omitempty"`
 	// Size is the size of the disk in GBs.
-	// Defaults to 30GB. For "local-ssd" size is always 375GB.
+	// Defaults to 30GB, or to the size of the source when SourceImage or SourceSnapshot is set.
+	// For "local-ssd" size is always 375GB.
 	// +optional
 	Size *int64 `json:"size,omitempty"`
+	// SourceImage is the full reference to an image to create the disk from.
+	// Cannot be set together with SourceSnapshot.
+	// +optional
+	SourceImage *string `json:"sourceImage,omitempty"`
+	// SourceSnapshot is the full reference to a snapshot to create the disk from.
+	// Cannot be set together with SourceImage.
+	// +optional
+	SourceSnapshot *string `json:"sourceSnapshot,omitempty"`
+	// Labels is an optional set of labels to apply to the disk.
+	// +optional
+	Labels Labels `json:"labels,omitempty"`
+	// ProvisionedIops is the number of I/O operations per second the disk can handle.
+	// Only supported for "pd-extreme", "hyperdisk-balanced" and "hyperdisk-extreme" disks.
+	// +optional
+	ProvisionedIops *int64 `json:"provisionedIops,omitempty"`
+	// ProvisionedThroughput is the throughput in MiB per second the disk can handle.
+	// Only supported for "hyperdisk-balanced" and "hyperdisk-throughput" disks.
+	// +optional
+	ProvisionedThroughput *int64 `json:"provisionedThroughput,omitempty"`
+	// RetainPolicy defines what happens to the disk when the instance is deleted.
+	// If Delete, the disk is deleted with the instance.
+	// If Retain, the disk is detached and kept, and can be re-attached to another machine through its Name.
+	// Retain requires Name or NameSuffix to be set and is not supported for "local-ssd" disks.
+	// Defaults to Delete.
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// +optional
+	RetainPolicy *DiskRetainPolicy `json:"retainPolicy,omitempty"`
 	// EncryptionKey defines the KMS key to be used to encrypt the disk.
 	// +optional
 	EncryptionKey *CustomerEncryptionKey `json:"encryptionKey,omitempty"`
 }
 
+const (
+	// EtcdDiskDeviceName is the device name of the etcd data disk. The guest OS exposes the disk
+	// as /dev/disk/by-id/google-etcd.
+	EtcdDiskDeviceName = "etcd"
+	// EtcdDiskMetadataKey is the instance metadata key through which the device path of the etcd data disk
+	// is exposed to bootstrap.
+	EtcdDiskMetadataKey = "etcd-disk-device"
+)
+
+// EtcdDiskSpec defines the dedicated disk holding the etcd data of a control plane machine.
+type EtcdDiskSpec struct {
+	// DeviceType is the type of the etcd disk.
+	// Supported types are "pd-ssd", "pd-balanced", "pd-extreme", "hyperdisk-balanced" and "hyperdisk-extreme".
+	// Default is "pd-ssd".
+	// +kubebuilder:validation:Enum=pd-ssd;pd-balanced;pd-extreme;hyperdisk-balanced;hyperdisk-extreme
+	// +optional
+	DeviceType *DiskType `json:"deviceType,omitempty"`
+	// Size is the size of the etcd disk in GBs.
+	// Defaults to 50GB.
+	// +optional
+	Size *int64 `json:"size,omitempty"`
+	// ProvisionedIops is the number of I/O operations per second the etcd disk can handle.
+	// Only supported for "pd-extreme", "hyperdisk-balanced" and "hyperdisk-extreme" disks.
+	// +optional
+	ProvisionedIops *int64 `json:"provisionedIops,omitempty"`
+}
+
 // IPForwarding represents the IP forwarding configuration for the GCP machine.
 type IPForwarding string
 
@@ -147,6 +257,46 @@ var (
 	confidentialMachineSeriesSupportingSevsnp = []string{"n2d"}
 )
 
+// Architecture represents the CPU architecture of the GCP machine.
+type Architecture string
+
+const (
+	// ArchitectureAMD64 is the x86-64 CPU architecture.
+	ArchitectureAMD64 Architecture = "amd64"
+	// ArchitectureARM64 is the 64-bit Arm CPU architecture.
+	ArchitectureARM64 Architecture = "arm64"
+)
+
+// The CPU architecture depends on the configured machine types, any series not listed is x86-64. Pre-flight checks
+// use the architecture the Compute API reports for the machine type instead, so that image lookups also work for
+// series missing here.
+// reference: https://cloud.google.com/compute/docs/instances/arm-on-compute
+var machineSeriesArm64 = []string{"t2a", "c4a", "n4a", "a4x"}
+
+// MachineTypeArchitecture returns the CPU architecture of the given machine type, derived from its machine series.
+func MachineTypeArchitecture(machineType string) Architecture {
+	machineSeries := strings.Split(machineType, "-")[0]
+	if slices.Contains(machineSeriesArm64, machineSeries) {
+		return ArchitectureARM64
+	}
+	return ArchitectureAMD64
+}
+
+// ImageArchitecture returns the CPU architecture of the given image or image family reference, derived from its
+// name, and whether it could be determined. Only names containing an architecture marker such as arm64 or amd64 are
+// recognized; the architecture of other images is only known from the Compute API.
+func ImageArchitecture(image string) (Architecture, bool) {
+	name := image[strings.LastIndex(image, "/")+1:]
+	switch {
+	case strings.Contains(name, "arm64"), strings.Contains(name, "aarch64"):
+		return ArchitectureARM64, true
+	case strings.Contains(name, "amd64"), strings.Contains(name, "x86-64"):
+		return ArchitectureAMD64, true
+	default:
+		return "", false
+	}
+}
+
 // HostMaintenancePolicy represents the desired behavior ase of a host maintenance event.
 type HostMaintenancePolicy string
 
@@ -175,6 +325,13 @@ type ManagedKey struct {
 	// +kubebuilder:validation:Pattern=`projects\/[-_[A-Za-z0-9]+\/locations\/[-_[A-Za-z0-9]+\/keyRings\/[-_[A-Za-z0-9]+\/cryptoKeys\/[-_[A-Za-z0-9]+`
 	// +kubebuilder:validation:MaxLength=160
 	KMSKeyName string `json:"kmsKeyName,omitempty"`
+	// KeyVersion pins the version of the KMS key used to encrypt the disk, for example "3".
+	// If omitted, the primary version of the key at the time the disk is created is used.
+	// In that case, the DiskEncryptionKeysUpToDate condition compares the disks with the current primary version,
+	// which requires the cloudkms.cryptoKeys.get permission.
+	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	// +optional
+	KeyVersion *string `json:"keyVersion,omitempty"`
 }
 
 // SuppliedKey contains a key for disk encryption. Either RawKey or RSAEncryptedKey must be provided.
@@ -233,9 +390,36 @@ const (
 	ProvisioningModelSpot ProvisioningModel = "Spot"
 )
 
+// DefaultImageLookupKubernetesVersionLabel is the image label matched against the Machine's Kubernetes version
+// when ImageLookup does not specify one.
+const DefaultImageLookupKubernetesVersionLabel = "kubernetes-version"
+
+// ImageLookup defines how to look up the boot image of an instance by its labels. At least one label or
+// the Kubernetes version of the Machine must be matched, rather than selecting an arbitrary image.
+type ImageLookup struct {
+	// Project is the GCP project to search for images.
+	// Defaults to the project of the cluster.
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// KubernetesVersionLabel is the image label that must match the Kubernetes version of the Machine.
+	// GCP label values cannot contain dots, so the version is matched with dots replaced by dashes, e.g. "v1-30-2".
+	// Set to an empty string to disable matching on the Kubernetes version.
+	// Defaults to "kubernetes-version".
+	// +optional
+	KubernetesVersionLabel *string `json:"kubernetesVersionLabel,omitempty"`
+
+	// Labels is a set of additional labels an image must carry to be selected, for example
+	// the operating system ("os": "ubuntu-2204"). Only images matching the CPU architecture of the
+	// machine type are selected.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
 // GCPMachineSpec defines the desired state of GCPMachine.
 type GCPMachineSpec struct {
 	// InstanceType is the type of instance to create. Example: n1.standard-2
+	// The CPU architecture of the instance is derived from the machine series, e.g. t2a and c4a machines are arm64.
 	InstanceType string `json:"instanceType"`
 
 	// Subnet is a reference to the subnetwork to use for this instance. If not specified,
@@ -248,6 +432,8 @@ type GCPMachineSpec struct {
 	ProviderID *string `json:"providerID,omitempty"`
 
 	// ImageFamily is the full reference to a valid image family to be used for this machine.
+	// When neither Image, ImageFamily nor ImageLookup is set, the default image family matching the
+	// Kubernetes version is used, suffixed with "-arm64" for arm64 machine types.
 	// +optional
 	ImageFamily *string `json:"imageFamily,omitempty"`
 
@@ -256,6 +442,12 @@ type GCPMachineSpec struct {
 	// +optional
 	Image *string `json:"image,omitempty"`
 
+	// ImageLookup selects the newest non-deprecated image matching a set of labels.
+	// It is only used when neither Image nor ImageFamily is set. The resolved image is
+	// recorded in the status and reused for the lifetime of the machine.
+	// +optional
+	ImageLookup *ImageLookup `json:"imageLookup,omitempty"`
+
 	// AdditionalLabels is an optional set of tags to add to an instance, in addition to the ones added by default by the
 	// GCP provider. If both the GCPCluster and the GCPMachine specify the same tag name with different values, the
 	// GCPMachine's value takes precedence.
@@ -309,6 +501,13 @@ type GCPMachineSpec struct {
 	// +optional
 	AdditionalDisks []AttachedDiskSpec `json:"additionalDisks,omitempty"`
 
+	// EtcdDisk is an optional dedicated disk for the etcd data of control plane machines.
+	// The disk is attached with the device name "etcd", and its device path is exposed to bootstrap
+	// through the "etcd-disk-device" instance metadata key so that it can be mounted on /var/lib/etcd.
+	// Ignored for machines that are not part of the control plane.
+	// +optional
+	EtcdDisk *EtcdDiskSpec `json:"etcdDisk,omitempty"`
+
 	// ServiceAccount specifies the service account email and which scopes to assign to the machine.
 	// Defaults to: email: "default", scope: []{compute.CloudPlatformScope}
 	// +optional
@@ -379,6 +578,14 @@ type GCPMachineStatus struct {
 	// +optional
 	InstanceStatus *InstanceStatus `json:"instanceState,omitempty"`
 
+	// Image is the self-link of the image resolved through ImageLookup for this machine.
+	// +optional
+	Image *string `json:"image,omitempty"`
+
+	// DiskEncryptionKeys lists the Cloud KMS key versions used to encrypt the disks of the instance.
+	// +optional
+	DiskEncryptionKeys []DiskEncryptionKeyStatus `json:"diskEncryptionKeys,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
@@ -416,6 +623,18 @@ type GCPMachineStatus struct {
 	// controller's output.
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// Conditions defines current service state of the GCPMachine.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// DiskEncryptionKeyStatus describes the Cloud KMS key version used to encrypt a disk of the instance.
+type DiskEncryptionKeyStatus struct {
+	// DeviceName is the device name of the disk on the instance.
+	DeviceName string `json:"deviceName"`
+	// KMSKeyVersion is the full name of the KMS key version used to encrypt the disk.
+	KMSKeyVersion string `json:"kmsKeyVersion"`
 }
 
 // +kubebuilder:object:root=true
@@ -437,6 +656,16 @@ type GCPMachine struct {
 	Status GCPMachineStatus `json:"status,omitempty"`
 }
 
+// GetConditions returns the observations of the operational state of the GCPMachine resource.
+func (m *GCPMachine) GetConditions() clusterv1.Conditions {
+	return m.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the GCPMachine to the predescribed clusterv1.Conditions.
+func (m *GCPMachine) SetConditions(conditions clusterv1.Conditions) {
+	m.Status.Conditions = conditions
+}
+
 // +kubebuilder:object:root=true
 
 // GCPMachineList contains a list of GCPMachine.
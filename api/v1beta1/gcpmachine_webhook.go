@@ -21,11 +21,13 @@ import (
 	"reflect"
 	"strings"
 
+	"k8s.io/utils/ptr"
 	"k8s.io/utils/strings/slices"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -54,7 +56,23 @@ func (m *GCPMachine) ValidateCreate() (admission.Warnings, error) {
 	if err := validateConfidentialCompute(m.Spec); err != nil {
 		return nil, err
 	}
-	return nil, validateCustomerEncryptionKey(m.Spec)
+	warnings, err := validateArchitecture(m.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAdditionalDisks(m.Spec); err != nil {
+		return nil, err
+	}
+	if err := validateAdditionalDiskNames(m.Name, m.Spec); err != nil {
+		return nil, err
+	}
+	if err := validateEtcdDisk(m.Spec); err != nil {
+		return nil, err
+	}
+	if err := validateCustomerEncryptionKey(m.Spec); err != nil {
+		return nil, err
+	}
+	return warnings, nil
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
@@ -131,12 +149,99 @@ func validateConfidentialCompute(spec GCPMachineSpec) error {
 	return nil
 }
 
+// validateArchitecture rejects images whose name marks them as built for another architecture than the one the
+// machine type requires. Images without an architecture marker in their name cannot be checked here; as most of
+// them are built for x86, arm64 machine types using one get a warning, and the image architecture reported by the
+// Compute API is checked again before the instance is created.
+func validateArchitecture(spec GCPMachineSpec) (admission.Warnings, error) {
+	image := spec.Image
+	if image == nil {
+		image = spec.ImageFamily
+	}
+	if image == nil {
+		return nil, nil
+	}
+
+	machineArchitecture := MachineTypeArchitecture(spec.InstanceType)
+	imageArchitecture, ok := ImageArchitecture(*image)
+	switch {
+	case ok && imageArchitecture != machineArchitecture:
+		return nil, fmt.Errorf("image %s is built for the %s architecture, but machine type %s requires %s", *image, imageArchitecture, spec.InstanceType, machineArchitecture)
+	case !ok && machineArchitecture == ArchitectureARM64:
+		return admission.Warnings{fmt.Sprintf("the architecture of image %s cannot be determined from its name, but machine type %s requires %s; make sure the image is built for %s", *image, spec.InstanceType, machineArchitecture, machineArchitecture)}, nil
+	}
+	return nil, nil
+}
+
+func validateAdditionalDisks(spec GCPMachineSpec) error {
+	for i, disk := range spec.AdditionalDisks {
+		diskType := string(ptr.Deref(disk.DeviceType, PdStandardDiskType))
+		if disk.Name != nil && disk.NameSuffix != nil {
+			return fmt.Errorf("AdditionalDisks[%d] requires either Name or NameSuffix to be set, not both", i)
+		}
+		if disk.NameSuffix != nil {
+			if errs := validation.IsDNS1035Label(*disk.NameSuffix); len(errs) > 0 {
+				return fmt.Errorf("AdditionalDisks[%d] NameSuffix %q is invalid: %s", i, *disk.NameSuffix, strings.Join(errs, ", "))
+			}
+		}
+		if ptr.Deref(disk.RetainPolicy, DiskRetainPolicyDelete) == DiskRetainPolicyRetain && disk.Name == nil && disk.NameSuffix == nil {
+			return fmt.Errorf("AdditionalDisks[%d] RetainPolicy %s requires Name or NameSuffix to be set", i, DiskRetainPolicyRetain)
+		}
+		if diskType == string(LocalSsdDiskType) {
+			if disk.Name != nil || disk.NameSuffix != nil || disk.SourceImage != nil || disk.SourceSnapshot != nil || ptr.Deref(disk.RetainPolicy, DiskRetainPolicyDelete) == DiskRetainPolicyRetain {
+				return fmt.Errorf("AdditionalDisks[%d] of type %s does not support Name, NameSuffix, SourceImage, SourceSnapshot or RetainPolicy %s", i, LocalSsdDiskType, DiskRetainPolicyRetain)
+			}
+		}
+		if disk.SourceImage != nil && disk.SourceSnapshot != nil {
+			return fmt.Errorf("AdditionalDisks[%d] requires either SourceImage or SourceSnapshot to be set, not both", i)
+		}
+		if disk.ProvisionedIops != nil && !slices.Contains(diskTypesSupportingProvisionedIops, diskType) {
+			return fmt.Errorf("AdditionalDisks[%d] ProvisionedIops requires any of the following disk types: %s. %s was found instead", i, strings.Join(diskTypesSupportingProvisionedIops, ", "), diskType)
+		}
+		if disk.ProvisionedThroughput != nil && !slices.Contains(diskTypesSupportingProvisionedThroughput, diskType) {
+			return fmt.Errorf("AdditionalDisks[%d] ProvisionedThroughput requires any of the following disk types: %s. %s was found instead", i, strings.Join(diskTypesSupportingProvisionedThroughput, ", "), diskType)
+		}
+	}
+	return nil
+}
+
+// validateAdditionalDiskNames checks that the disks named after the machine get a valid name.
+func validateAdditionalDiskNames(machineName string, spec GCPMachineSpec) error {
+	for i, disk := range spec.AdditionalDisks {
+		if disk.NameSuffix == nil || machineName == "" {
+			continue
+		}
+		if name := machineName + "-" + *disk.NameSuffix; len(name) > validation.DNS1035LabelMaxLength {
+			return fmt.Errorf("AdditionalDisks[%d] name %q, made of the machine name and NameSuffix, must be no more than %d characters", i, name, validation.DNS1035LabelMaxLength)
+		}
+	}
+	return nil
+}
+
+func validateEtcdDisk(spec GCPMachineSpec) error {
+	if spec.EtcdDisk == nil {
+		return nil
+	}
+
+	diskType := string(ptr.Deref(spec.EtcdDisk.DeviceType, PdSsdDiskType))
+	if spec.EtcdDisk.ProvisionedIops != nil && !slices.Contains(diskTypesSupportingProvisionedIops, diskType) {
+		return fmt.Errorf("EtcdDisk ProvisionedIops requires any of the following disk types: %s. %s was found instead", strings.Join(diskTypesSupportingProvisionedIops, ", "), diskType)
+	}
+	return nil
+}
+
 func checkKeyType(key *CustomerEncryptionKey) error {
 	switch key.KeyType {
 	case CustomerManagedKey:
 		if key.ManagedKey == nil || key.SuppliedKey != nil {
 			return errors.New("CustomerEncryptionKey KeyType of Managed requires only ManagedKey to be set")
 		}
+		if key.ManagedKey.KMSKeyName == "" {
+			return errors.New("CustomerEncryptionKey KeyType of Managed requires KMSKeyName to be set")
+		}
+		if strings.Contains(key.ManagedKey.KMSKeyName, "/cryptoKeyVersions/") {
+			return errors.New("CustomerEncryptionKey KMSKeyName must not contain a key version, use KeyVersion to pin one")
+		}
 	case CustomerSuppliedKey:
 		if key.SuppliedKey == nil || key.ManagedKey != nil {
 			return errors.New("CustomerEncryptionKey KeyType of Supplied requires only SuppliedKey to be set")
@@ -144,6 +249,14 @@ func checkKeyType(key *CustomerEncryptionKey) error {
 		if len(key.SuppliedKey.RawKey) > 0 && len(key.SuppliedKey.RSAEncryptedKey) > 0 {
 			return errors.New("CustomerEncryptionKey KeyType of Supplied requires either RawKey or RSAEncryptedKey to be set, not both")
 		}
+		if len(key.SuppliedKey.RawKey) == 0 && len(key.SuppliedKey.RSAEncryptedKey) == 0 {
+			return errors.New("CustomerEncryptionKey KeyType of Supplied requires either RawKey or RSAEncryptedKey to be set")
+		}
+		// RawKey and RSAEncryptedKey are []byte, so the base64 encoding from the
+		// manifest has already been decoded by the time it reaches the webhook.
+		if len(key.SuppliedKey.RawKey) > 0 && len(key.SuppliedKey.RawKey) != 32 {
+			return errors.New("CustomerEncryptionKey RawKey must be a base64 encoded 256-bit key")
+		}
 	default:
 		return fmt.Errorf("invalid value for CustomerEncryptionKey KeyType %s", key.KeyType)
 	}
@@ -157,10 +270,10 @@ func validateCustomerEncryptionKey(spec GCPMachineSpec) error {
 		}
 	}
 
-	for _, disk := range spec.AdditionalDisks {
+	for i, disk := range spec.AdditionalDisks {
 		if disk.EncryptionKey != nil {
 			if err := checkKeyType(disk.EncryptionKey); err != nil {
-				return err
+				return errors.Wrapf(err, "AdditionalDisks[%d]", i)
 			}
 		}
 	}
@@ -17,9 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestGCPMachine_ValidateCreate(t *testing.T) {
@@ -30,10 +34,18 @@ func TestGCPMachine_ValidateCreate(t *testing.T) {
 	confidentialComputeFooBar := ConfidentialComputePolicy("foobar")
 	onHostMaintenanceTerminate := HostMaintenancePolicyTerminate
 	onHostMaintenanceMigrate := HostMaintenancePolicyMigrate
+	// Supplied keys reach the webhook already decoded from the base64 in the manifest.
+	rawKey, err := base64.StdEncoding.DecodeString("SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0=")
+	g.Expect(err).NotTo(HaveOccurred())
+	shortRawKey, err := base64.StdEncoding.DecodeString("SGVsbG8=")
+	g.Expect(err).NotTo(HaveOccurred())
+	rsaEncryptedKey, err := base64.StdEncoding.DecodeString("ieCx/NcW06PcT7Ep1X6LUTc/hLvUDYyzSZPPVCVPTVEohpeHASqC8uw5TzyO9U+Fka9JFHiz0mBibXUInrC/jEk014kCK/NPjYgEMOyssZ4ZINPKxlUh2zn1bV+MCaTICrdmuSBTWlUUiFoDiD6PYznLwh8ZNdaheCeZ8ewEXgFQ8V+sDroLaN3Xs3MDTXQEMMoNUXMCZEIpg9Vtp9x2oe==")
+	g.Expect(err).NotTo(HaveOccurred())
 	tests := []struct {
 		name string
 		*GCPMachine
-		wantErr bool
+		wantErr  bool
+		wantWarn bool
 	}{
 		{
 			name: "GCPMachined with OnHostMaintenance set to Terminate - valid",
@@ -201,6 +213,223 @@ func TestGCPMachine_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "GCPMachine with arm64 machine type and arm64 image family - valid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					InstanceType: "t2a-standard-4",
+					ImageFamily:  ptr.To[string]("projects/ubuntu-os-cloud/global/images/family/ubuntu-2204-lts-arm64"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachine with arm64 machine type and amd64 image - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					InstanceType: "c4a-standard-4",
+					Image:        ptr.To[string]("projects/ubuntu-os-cloud/global/images/ubuntu-2204-jammy-amd64-v20240701"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with N4A machine type and amd64 image - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					InstanceType: "n4a-standard-4",
+					Image:        ptr.To[string]("projects/ubuntu-os-cloud/global/images/ubuntu-2204-jammy-amd64-v20240701"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with x86-64 machine type and arm64 image family - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					InstanceType: "n2-standard-4",
+					ImageFamily:  ptr.To[string]("projects/cos-cloud/global/images/family/cos-arm64-stable"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with arm64 machine type and image of unknown architecture - valid with warning",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					InstanceType: "t2a-standard-4",
+					Image:        ptr.To[string]("projects/my-proj/global/images/my-custom-image"),
+				},
+			},
+			wantErr:  false,
+			wantWarn: true,
+		},
+		{
+			name: "GCPMachine with x86-64 machine type and image of unknown architecture - valid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					InstanceType: "n2-standard-4",
+					Image:        ptr.To[string]("projects/my-proj/global/images/my-custom-image"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachine with retained AdditionalDisk and Name set - valid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							DeviceType:   ptr.To(HyperdiskBalancedDiskType),
+							Name:         ptr.To[string]("my-machine-data"),
+							RetainPolicy: ptr.To(DiskRetainPolicyRetain),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachine with retained AdditionalDisk and Name not set - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							RetainPolicy: ptr.To(DiskRetainPolicyRetain),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with retained AdditionalDisk and NameSuffix set - valid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							NameSuffix:   ptr.To[string]("data"),
+							RetainPolicy: ptr.To(DiskRetainPolicyRetain),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachine with AdditionalDisk Name and NameSuffix set - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							Name:       ptr.To[string]("my-machine-data"),
+							NameSuffix: ptr.To[string]("data"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with AdditionalDisk NameSuffix not an RFC 1035 label - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							NameSuffix: ptr.To[string]("1data"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with AdditionalDisk named after a machine name too long - invalid",
+			GCPMachine: &GCPMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster-control-plane-with-a-rather-long-name-abcde",
+				},
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							NameSuffix: ptr.To[string]("etcd-data"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with local-ssd AdditionalDisk and NameSuffix set - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							DeviceType: ptr.To(LocalSsdDiskType),
+							NameSuffix: ptr.To[string]("scratch"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with local-ssd AdditionalDisk and SourceSnapshot set - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							DeviceType:     ptr.To(LocalSsdDiskType),
+							SourceSnapshot: ptr.To[string]("projects/my-proj/global/snapshots/my-snapshot"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with AdditionalDisk SourceImage and SourceSnapshot set - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							SourceImage:    ptr.To[string]("projects/my-proj/global/images/my-image"),
+							SourceSnapshot: ptr.To[string]("projects/my-proj/global/snapshots/my-snapshot"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with hyperdisk-balanced AdditionalDisk and provisioned performance - valid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							DeviceType:            ptr.To(HyperdiskBalancedDiskType),
+							ProvisionedIops:       ptr.To[int64](6000),
+							ProvisionedThroughput: ptr.To[int64](290),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachine with pd-ssd AdditionalDisk and ProvisionedIops - invalid",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							DeviceType:      ptr.To(PdSsdDiskType),
+							ProvisionedIops: ptr.To[int64](6000),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "GCPMachine with AdditionalDisk Encryption KeyType Managed and Managed field not set",
 			GCPMachine: &GCPMachine{
@@ -216,6 +445,73 @@ func TestGCPMachine_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "GCPMachine with AdditionalDisk Encryption KeyType Managed and pinned KeyVersion",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							EncryptionKey: &CustomerEncryptionKey{
+								KeyType: CustomerManagedKey,
+								ManagedKey: &ManagedKey{
+									KMSKeyName: "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key",
+									KeyVersion: ptr.To[string]("2"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachine with AdditionalDisk Encryption KeyType Managed and key version in KMSKeyName",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							EncryptionKey: &CustomerEncryptionKey{
+								KeyType: CustomerManagedKey,
+								ManagedKey: &ManagedKey{
+									KMSKeyName: "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key/cryptoKeyVersions/2",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with AdditionalDisk Encryption KeyType Supplied and no key material",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					AdditionalDisks: []AttachedDiskSpec{
+						{
+							EncryptionKey: &CustomerEncryptionKey{
+								KeyType:     CustomerSuppliedKey,
+								SuppliedKey: &SuppliedKey{},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachine with RootDiskEncryptionKey KeyType Supplied and RawKey not a 256-bit key",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					RootDiskEncryptionKey: &CustomerEncryptionKey{
+						KeyType: CustomerSuppliedKey,
+						SuppliedKey: &SuppliedKey{
+							RawKey: shortRawKey,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "GCPMachine with RootDiskEncryptionKey KeyType Supplied and one Supplied field set",
 			GCPMachine: &GCPMachine{
@@ -223,7 +519,21 @@ func TestGCPMachine_ValidateCreate(t *testing.T) {
 					RootDiskEncryptionKey: &CustomerEncryptionKey{
 						KeyType: CustomerSuppliedKey,
 						SuppliedKey: &SuppliedKey{
-							RawKey: []byte("SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0="),
+							RawKey: rawKey,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachine with RootDiskEncryptionKey KeyType Supplied and RSAEncryptedKey set",
+			GCPMachine: &GCPMachine{
+				Spec: GCPMachineSpec{
+					RootDiskEncryptionKey: &CustomerEncryptionKey{
+						KeyType: CustomerSuppliedKey,
+						SuppliedKey: &SuppliedKey{
+							RSAEncryptedKey: rsaEncryptedKey,
 						},
 					},
 				},
@@ -237,8 +547,8 @@ func TestGCPMachine_ValidateCreate(t *testing.T) {
 					RootDiskEncryptionKey: &CustomerEncryptionKey{
 						KeyType: CustomerSuppliedKey,
 						SuppliedKey: &SuppliedKey{
-							RawKey:          []byte("SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0="),
-							RSAEncryptedKey: []byte("SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0="),
+							RawKey:          rawKey,
+							RSAEncryptedKey: rsaEncryptedKey,
 						},
 					},
 				},
@@ -255,7 +565,24 @@ func TestGCPMachine_ValidateCreate(t *testing.T) {
 			} else {
 				g.Expect(err).NotTo(HaveOccurred())
 			}
-			g.Expect(warn).To(BeNil())
+			if test.wantWarn {
+				g.Expect(warn).To(HaveLen(1))
+			} else {
+				g.Expect(warn).To(BeNil())
+			}
 		})
 	}
 }
+
+func TestGCPMachine_ValidateCreateSuppliedKeyFromJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	// The RawKey example from the API documentation must pass validation once decoded from JSON.
+	machine := &GCPMachine{}
+	g.Expect(json.Unmarshal([]byte(`{"spec":{"instanceType":"n1-standard-2","rootDiskEncryptionKey":{"keyType":"Supplied","suppliedKey":{"rawKey":"SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0="}}}}`), machine)).To(Succeed())
+	g.Expect(machine.Spec.RootDiskEncryptionKey.SuppliedKey.RawKey).To(HaveLen(32))
+
+	warn, err := machine.ValidateCreate()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(warn).To(BeNil())
+}
@@ -17,12 +17,14 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -47,7 +49,41 @@ var _ webhook.Validator = &GCPMachineTemplate{}
 func (r *GCPMachineTemplate) ValidateCreate() (admission.Warnings, error) {
 	clusterlog.Info("validate create", "name", r.Name)
 
-	return nil, validateConfidentialCompute(r.Spec.Template.Spec)
+	if err := validateConfidentialCompute(r.Spec.Template.Spec); err != nil {
+		return nil, err
+	}
+	warnings, err := validateArchitecture(r.Spec.Template.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAdditionalDisks(r.Spec.Template.Spec); err != nil {
+		return nil, err
+	}
+	if err := validateTemplateAdditionalDisks(r.Spec.Template.Spec); err != nil {
+		return nil, err
+	}
+	if err := validateEtcdDisk(r.Spec.Template.Spec); err != nil {
+		return nil, err
+	}
+	if err := validateCustomerEncryptionKey(r.Spec.Template.Spec); err != nil {
+		return nil, err
+	}
+	return warnings, nil
+}
+
+// validateTemplateAdditionalDisks rejects named additional disks, as every machine created from the template
+// would attach or create the same zonal disk. Disks named after the machine through NameSuffix are allowed, but
+// cannot be retained: machines created from a template are replaced under new names and would never re-attach them.
+func validateTemplateAdditionalDisks(spec GCPMachineSpec) error {
+	for i, disk := range spec.AdditionalDisks {
+		if disk.Name != nil {
+			return fmt.Errorf("AdditionalDisks[%d] Name is not supported in a GCPMachineTemplate, as every machine would share the disk, use NameSuffix instead", i)
+		}
+		if disk.NameSuffix != nil && ptr.Deref(disk.RetainPolicy, DiskRetainPolicyDelete) == DiskRetainPolicyRetain {
+			return fmt.Errorf("AdditionalDisks[%d] RetainPolicy %s is not supported with NameSuffix in a GCPMachineTemplate, as replacement machines get new names and every retained disk would be orphaned", i, DiskRetainPolicyRetain)
+		}
+	}
+	return nil
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
 )
 
 func TestGCPMachineTemplate_ValidateCreate(t *testing.T) {
@@ -33,6 +34,7 @@ func TestGCPMachineTemplate_ValidateCreate(t *testing.T) {
 		name     string
 		template *GCPMachineTemplate
 		wantErr  bool
+		wantWarn bool
 	}{
 		{
 			name: "GCPMachineTemplate with OnHostMaintenance set to Terminate - valid",
@@ -197,6 +199,110 @@ func TestGCPMachineTemplate_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "GCPMachineTemplate with hyperdisk-balanced EtcdDisk and ProvisionedIops - valid",
+			template: &GCPMachineTemplate{
+				Spec: GCPMachineTemplateSpec{
+					Template: GCPMachineTemplateResource{
+						Spec: GCPMachineSpec{
+							InstanceType: "n2-standard-4",
+							EtcdDisk: &EtcdDiskSpec{
+								DeviceType:      ptr.To(HyperdiskBalancedDiskType),
+								ProvisionedIops: ptr.To[int64](5000),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachineTemplate with default EtcdDisk type and ProvisionedIops - invalid",
+			template: &GCPMachineTemplate{
+				Spec: GCPMachineTemplateSpec{
+					Template: GCPMachineTemplateResource{
+						Spec: GCPMachineSpec{
+							InstanceType: "n2-standard-4",
+							EtcdDisk: &EtcdDiskSpec{
+								ProvisionedIops: ptr.To[int64](5000),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachineTemplate with named AdditionalDisk - invalid",
+			template: &GCPMachineTemplate{
+				Spec: GCPMachineTemplateSpec{
+					Template: GCPMachineTemplateResource{
+						Spec: GCPMachineSpec{
+							InstanceType: "n2-standard-4",
+							AdditionalDisks: []AttachedDiskSpec{
+								{
+									Name:         ptr.To("data"),
+									RetainPolicy: ptr.To(DiskRetainPolicyRetain),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachineTemplate with AdditionalDisk named after the machine - valid",
+			template: &GCPMachineTemplate{
+				Spec: GCPMachineTemplateSpec{
+					Template: GCPMachineTemplateResource{
+						Spec: GCPMachineSpec{
+							InstanceType: "n2-standard-4",
+							AdditionalDisks: []AttachedDiskSpec{
+								{
+									NameSuffix: ptr.To("data"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "GCPMachineTemplate with retained AdditionalDisk named after the machine - invalid",
+			template: &GCPMachineTemplate{
+				Spec: GCPMachineTemplateSpec{
+					Template: GCPMachineTemplateResource{
+						Spec: GCPMachineSpec{
+							InstanceType: "n2-standard-4",
+							AdditionalDisks: []AttachedDiskSpec{
+								{
+									NameSuffix:   ptr.To("data"),
+									RetainPolicy: ptr.To(DiskRetainPolicyRetain),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "GCPMachineTemplate with arm64 machine type and image of unknown architecture - valid with warning",
+			template: &GCPMachineTemplate{
+				Spec: GCPMachineTemplateSpec{
+					Template: GCPMachineTemplateResource{
+						Spec: GCPMachineSpec{
+							InstanceType: "c4a-standard-4",
+							Image:        ptr.To[string]("projects/my-proj/global/images/my-custom-image"),
+						},
+					},
+				},
+			},
+			wantErr:  false,
+			wantWarn: true,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -207,7 +313,11 @@ func TestGCPMachineTemplate_ValidateCreate(t *testing.T) {
 			} else {
 				g.Expect(err).NotTo(HaveOccurred())
 			}
-			g.Expect(warn).To(BeNil())
+			if test.wantWarn {
+				g.Expect(warn).To(HaveLen(1))
+			} else {
+				g.Expect(warn).To(BeNil())
+			}
 		})
 	}
 }
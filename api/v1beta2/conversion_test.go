@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+
+	t.Run("for GCPCluster", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme: scheme,
+		Hub:    &infrav1.GCPCluster{},
+		Spoke:  &GCPCluster{},
+	}))
+
+	t.Run("for GCPClusterTemplate", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme: scheme,
+		Hub:    &infrav1.GCPClusterTemplate{},
+		Spoke:  &GCPClusterTemplate{},
+	}))
+
+	t.Run("for GCPMachine", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme: scheme,
+		Hub:    &infrav1.GCPMachine{},
+		Spoke:  &GCPMachine{},
+	}))
+
+	t.Run("for GCPMachineTemplate", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme: scheme,
+		Hub:    &infrav1.GCPMachineTemplate{},
+		Spoke:  &GCPMachineTemplate{},
+	}))
+}
+
+func TestConvertGCPMachineProvisioningModel(t *testing.T) {
+	tests := []struct {
+		name              string
+		preemptible       bool
+		provisioningModel *infrav1.ProvisioningModel
+		want              *ProvisioningModel
+	}{
+		{
+			name: "standard by default",
+		},
+		{
+			name:              "standard",
+			provisioningModel: ptr.To(infrav1.ProvisioningModelStandard),
+			want:              ptr.To(ProvisioningModelStandard),
+		},
+		{
+			name:        "preemptible",
+			preemptible: true,
+			want:        ptr.To(ProvisioningModelPreemptible),
+		},
+		{
+			name:              "preemptible with the standard provisioning model",
+			preemptible:       true,
+			provisioningModel: ptr.To(infrav1.ProvisioningModelStandard),
+			want:              ptr.To(ProvisioningModelPreemptible),
+		},
+		{
+			name:              "spot takes precedence over preemptible",
+			preemptible:       true,
+			provisioningModel: ptr.To(infrav1.ProvisioningModelSpot),
+			want:              ptr.To(ProvisioningModelSpot),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			hub := &infrav1.GCPMachine{
+				Spec: infrav1.GCPMachineSpec{
+					Preemptible:       tt.preemptible,
+					ProvisioningModel: tt.provisioningModel,
+				},
+			}
+
+			spoke := &GCPMachine{}
+			g.Expect(spoke.ConvertFrom(hub)).To(Succeed())
+			g.Expect(spoke.Spec.ProvisioningModel).To(Equal(tt.want))
+
+			// Without the annotation preserved by ConvertFrom, only the provisioning model is left to convert back.
+			spoke.Annotations = nil
+			converted := &infrav1.GCPMachine{}
+			g.Expect(spoke.ConvertTo(converted)).To(Succeed())
+			g.Expect(converted.Spec.Preemptible).To(Equal(tt.want != nil && *tt.want == ProvisioningModelPreemptible))
+		})
+	}
+}
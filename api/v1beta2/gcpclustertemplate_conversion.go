@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this GCPClusterTemplate to the Hub version (v1beta1).
+func (src *GCPClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1.GCPClusterTemplate)
+	return Convert_v1beta2_GCPClusterTemplate_To_v1beta1_GCPClusterTemplate(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1.GCPClusterTemplate)
+	return Convert_v1beta1_GCPClusterTemplate_To_v1beta2_GCPClusterTemplate(src, dst, nil)
+}
+
+// ConvertTo converts this GCPClusterTemplateList to the Hub version (v1beta1).
+func (src *GCPClusterTemplateList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1.GCPClusterTemplateList)
+	return Convert_v1beta2_GCPClusterTemplateList_To_v1beta1_GCPClusterTemplateList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPClusterTemplateList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1.GCPClusterTemplateList)
+	return Convert_v1beta1_GCPClusterTemplateList_To_v1beta2_GCPClusterTemplateList(src, dst, nil)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this GCPMachine to the Hub version (v1beta1).
+func (src *GCPMachine) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1.GCPMachine)
+	if err := Convert_v1beta2_GCPMachine_To_v1beta1_GCPMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1.GCPMachine{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	restoreGCPMachineSpec(&src.Spec, &restored.Spec, &dst.Spec)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPMachine) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1.GCPMachine)
+	if err := Convert_v1beta1_GCPMachine_To_v1beta2_GCPMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion.
+	return utilconversion.MarshalData(src, dst)
+}
+
+// ConvertTo converts this GCPMachineList to the Hub version (v1beta1).
+func (src *GCPMachineList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1.GCPMachineList)
+	return Convert_v1beta2_GCPMachineList_To_v1beta1_GCPMachineList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPMachineList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1.GCPMachineList)
+	return Convert_v1beta1_GCPMachineList_To_v1beta2_GCPMachineList(src, dst, nil)
+}
+
+// Convert_v1beta1_GCPMachineSpec_To_v1beta2_GCPMachineSpec folds Preemptible into ProvisioningModel.
+func Convert_v1beta1_GCPMachineSpec_To_v1beta2_GCPMachineSpec(in *infrav1.GCPMachineSpec, out *GCPMachineSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_GCPMachineSpec_To_v1beta2_GCPMachineSpec(in, out, s); err != nil {
+		return err
+	}
+	out.ProvisioningModel = convertToProvisioningModel(in.Preemptible, in.ProvisioningModel)
+
+	return nil
+}
+
+// Convert_v1beta2_GCPMachineSpec_To_v1beta1_GCPMachineSpec converts the Preemptible provisioning model back to the
+// Preemptible field.
+func Convert_v1beta2_GCPMachineSpec_To_v1beta1_GCPMachineSpec(in *GCPMachineSpec, out *infrav1.GCPMachineSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta2_GCPMachineSpec_To_v1beta1_GCPMachineSpec(in, out, s); err != nil {
+		return err
+	}
+	if ptr.Deref(in.ProvisioningModel, "") == ProvisioningModelPreemptible {
+		out.Preemptible = true
+		out.ProvisioningModel = nil
+	}
+
+	return nil
+}
+
+// convertToProvisioningModel returns the v1beta2 provisioning model of a v1beta1 machine. Spot takes precedence
+// over Preemptible, matching how the instance is created.
+func convertToProvisioningModel(preemptible bool, model *infrav1.ProvisioningModel) *ProvisioningModel {
+	if preemptible && (model == nil || *model == infrav1.ProvisioningModelStandard) {
+		return ptr.To(ProvisioningModelPreemptible)
+	}
+
+	return (*ProvisioningModel)(model)
+}
+
+// restoreGCPMachineSpec restores the exact v1beta1 Preemptible and ProvisioningModel combination, as long as the
+// provisioning model was not changed in v1beta2.
+func restoreGCPMachineSpec(src *GCPMachineSpec, restored, dst *infrav1.GCPMachineSpec) {
+	if ptr.Equal(src.ProvisioningModel, convertToProvisioningModel(restored.Preemptible, restored.ProvisioningModel)) {
+		dst.Preemptible = restored.Preemptible
+		dst.ProvisioningModel = restored.ProvisioningModel
+	}
+}
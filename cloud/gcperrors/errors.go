@@ -43,3 +43,14 @@ func IgnoreNotFound(err error) error {
 
 	return err
 }
+
+// IsForbidden reports whether err is a Google API error
+// with http.StatusForbidden.
+func IsForbidden(err error) bool {
+	if err == nil {
+		return false
+	}
+	ae, ok := err.(*googleapi.Error)
+
+	return ok && ae.Code == http.StatusForbidden
+}
@@ -22,6 +22,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -46,6 +48,8 @@ type ReconcilerWithResult interface {
 type Client interface {
 	Cloud() Cloud
 	NetworkCloud() Cloud
+	ComputeService() *compute.Service
+	KMSService(ctx context.Context) (*cloudkms.Service, error)
 }
 
 // ClusterGetter is an interface which can get cluster information.
@@ -27,7 +27,9 @@ import (
 	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/pkg/errors"
+	"google.golang.org/api/cloudkms/v1"
 	"google.golang.org/api/compute/v1"
+	gkehub "google.golang.org/api/gkehub/v1"
 	"google.golang.org/api/option"
 	"k8s.io/client-go/pkg/version"
 	"k8s.io/client-go/util/flowcontrol"
@@ -38,6 +40,8 @@ import (
 // GCPServices contains all the gcp services used by the scopes.
 type GCPServices struct {
 	Compute *compute.Service
+	// KMS is created on first use, as only machines with customer-managed encryption keys need it.
+	KMS *cloudkms.Service
 }
 
 // GCPRateLimiter implements cloud.RateLimiter.
@@ -182,3 +186,35 @@ func newTagBindingsClient(ctx context.Context, credentialsRef *infrav1.ObjectRef
 
 	return client, nil
 }
+
+func newGKEHubService(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client, endpoints *infrav1.ServiceEndpoints) (*gkehub.Service, error) {
+	opts, err := defaultClientOptions(ctx, credentialsRef, crClient)
+	if err != nil {
+		return nil, fmt.Errorf("getting default gcp client options: %w", err)
+	}
+
+	if endpoints != nil && endpoints.GKEHubServiceEndpoint != "" {
+		opts = append(opts, option.WithEndpoint(endpoints.GKEHubServiceEndpoint))
+	}
+
+	hubSvc, err := gkehub.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Errorf("failed to create gcp gke hub service: %v", err)
+	}
+
+	return hubSvc, nil
+}
+
+func newKMSService(ctx context.Context, credentialsRef *infrav1.ObjectReference, crClient client.Client) (*cloudkms.Service, error) {
+	opts, err := defaultClientOptions(ctx, credentialsRef, crClient)
+	if err != nil {
+		return nil, fmt.Errorf("getting default gcp client options: %w", err)
+	}
+
+	kmsSvc, err := cloudkms.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Errorf("failed to create gcp kms service: %v", err)
+	}
+
+	return kmsSvc, nil
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/api/cloudkms/v1"
 	"google.golang.org/api/compute/v1"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
@@ -95,6 +96,25 @@ func (s *ClusterScope) NetworkCloud() cloud.Cloud {
 	return newCloud(s.NetworkProject(), s.GCPServices)
 }
 
+// ComputeService returns the compute service, for the APIs the cloud does not provide.
+func (s *ClusterScope) ComputeService() *compute.Service {
+	return s.GCPServices.Compute
+}
+
+// KMSService returns the Cloud KMS service, creating it on first use.
+func (s *ClusterScope) KMSService(ctx context.Context) (*cloudkms.Service, error) {
+	if s.GCPServices.KMS == nil {
+		kmsSvc, err := newKMSService(ctx, s.GCPCluster.Spec.CredentialsRef, s.client)
+		if err != nil {
+			return nil, err
+		}
+
+		s.GCPServices.KMS = kmsSvc
+	}
+
+	return s.GCPServices.KMS, nil
+}
+
 // Project returns the current project name.
 func (s *ClusterScope) Project() string {
 	return s.GCPCluster.Spec.Project
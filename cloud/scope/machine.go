@@ -19,6 +19,7 @@ package scope
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"path"
 	"sort"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/mod/semver"
+	"google.golang.org/api/cloudkms/v1"
 	"google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,6 +40,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/shared"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -98,6 +101,16 @@ func (m *MachineScope) NetworkCloud() cloud.Cloud {
 	return m.ClusterGetter.NetworkCloud()
 }
 
+// ComputeService returns the compute service of the cluster.
+func (m *MachineScope) ComputeService() *compute.Service {
+	return m.ClusterGetter.ComputeService()
+}
+
+// KMSService returns the Cloud KMS service of the cluster.
+func (m *MachineScope) KMSService(ctx context.Context) (*cloudkms.Service, error) {
+	return m.ClusterGetter.KMSService(ctx)
+}
+
 // Zone returns the FailureDomain for the GCPMachine.
 func (m *MachineScope) Zone() string {
 	if m.Machine.Spec.FailureDomain == nil {
@@ -169,6 +182,25 @@ func (m *MachineScope) GetProviderID() string {
 	return ""
 }
 
+// Architecture returns the CPU architecture of the GCPMachine, derived from its instance type.
+func (m *MachineScope) Architecture() infrav1.Architecture {
+	return infrav1.MachineTypeArchitecture(m.GCPMachine.Spec.InstanceType)
+}
+
+// KubernetesVersion returns the Kubernetes version of the Machine.
+func (m *MachineScope) KubernetesVersion() string {
+	return ptr.Deref(m.Machine.Spec.Version, "")
+}
+
+// ImageLookup returns the image lookup configuration of the GCPMachine. It returns nil when the image
+// is given explicitly through Image or ImageFamily, or has already been resolved for this machine.
+func (m *MachineScope) ImageLookup() *infrav1.ImageLookup {
+	if m.GCPMachine.Spec.Image != nil || m.GCPMachine.Spec.ImageFamily != nil || m.GCPMachine.Status.Image != nil {
+		return nil
+	}
+	return m.GCPMachine.Spec.ImageLookup
+}
+
 // ANCHOR_END: MachineGetter
 
 // ANCHOR: MachineSetter
@@ -217,6 +249,82 @@ func (m *MachineScope) SetAddresses(addressList []corev1.NodeAddress) {
 	m.GCPMachine.Status.Addresses = addressList
 }
 
+// SetImage records the image resolved through the image lookup in the GCPMachine status.
+func (m *MachineScope) SetImage(v string) {
+	m.GCPMachine.Status.Image = &v
+}
+
+// SetDiskEncryptionKeys records the Cloud KMS key versions used to encrypt the given instance disks, and reports
+// disks encrypted with another key version than the expected one, e.g. after a key rotation, through a condition.
+// Disks are expected to use the pinned key version, or the primary version of their key, as found in primaryKeyVersions
+// by key name, when no version is pinned. Disks using a key whose primary version is unknown cannot be compared,
+// which is reported unless another disk is known to be out of date.
+func (m *MachineScope) SetDiskEncryptionKeys(disks []*compute.AttachedDisk, primaryKeyVersions map[string]string) {
+	var diskEncryptionKeys []infrav1.DiskEncryptionKeyStatus
+	var mismatched, unknown []string
+	for _, disk := range disks {
+		if disk.DiskEncryptionKey == nil || disk.DiskEncryptionKey.KmsKeyName == "" {
+			continue
+		}
+
+		diskEncryptionKeys = append(diskEncryptionKeys, infrav1.DiskEncryptionKeyStatus{
+			DeviceName:    disk.DeviceName,
+			KMSKeyVersion: disk.DiskEncryptionKey.KmsKeyName,
+		})
+		key := m.diskEncryptionKeySpec(disk)
+		if key == nil || key.ManagedKey == nil {
+			continue
+		}
+		expected := kmsKeyName(key.ManagedKey)
+		if key.ManagedKey.KeyVersion == nil {
+			expected = primaryKeyVersions[key.ManagedKey.KMSKeyName]
+		}
+		switch {
+		case expected == "":
+			unknown = append(unknown, disk.DeviceName)
+		case disk.DiskEncryptionKey.KmsKeyName != expected:
+			mismatched = append(mismatched, disk.DeviceName)
+		}
+	}
+
+	m.GCPMachine.Status.DiskEncryptionKeys = diskEncryptionKeys
+	switch {
+	case len(diskEncryptionKeys) == 0:
+		conditions.Delete(m.GCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition)
+	case len(mismatched) > 0:
+		conditions.MarkFalse(m.GCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition, infrav1.DiskEncryptionKeyVersionMismatchReason, clusterv1.ConditionSeverityWarning,
+			"Disks %s are not encrypted with the expected key version", strings.Join(mismatched, ", "))
+	case len(unknown) > 0:
+		conditions.MarkUnknown(m.GCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition, infrav1.DiskEncryptionKeyVersionUnknownReason,
+			"The primary key version of disks %s could not be read from Cloud KMS, which requires the cloudkms.cryptoKeys.get permission", strings.Join(unknown, ", "))
+	default:
+		conditions.MarkTrue(m.GCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition)
+	}
+}
+
+// diskEncryptionKeySpec returns the encryption key requested in the spec for the given instance disk. Additional
+// disks are matched on their name when the spec names them, and otherwise on the device name Compute Engine gives
+// them after their position in the instance request, so that the order the disks are returned in does not matter.
+func (m *MachineScope) diskEncryptionKeySpec(disk *compute.AttachedDisk) *infrav1.CustomerEncryptionKey {
+	if disk.Boot {
+		return m.GCPMachine.Spec.RootDiskEncryptionKey
+	}
+
+	for i, additionalDisk := range m.GCPMachine.Spec.AdditionalDisks {
+		if name := m.additionalDiskName(additionalDisk); name != "" {
+			if disk.Source != "" && path.Base(disk.Source) == name {
+				return additionalDisk.EncryptionKey
+			}
+			continue
+		}
+		if disk.DeviceName == fmt.Sprintf("persistent-disk-%d", i+1) {
+			return additionalDisk.EncryptionKey
+		}
+	}
+
+	return nil
+}
+
 // ANCHOR_END: MachineSetter
 
 // ANCHOR: MachineInstanceSpec
@@ -228,11 +336,16 @@ func (m *MachineScope) InstanceImageSpec() *compute.AttachedDisk {
 		version = *m.Machine.Spec.Version
 	}
 	image := "capi-ubuntu-1804-k8s-" + strings.ReplaceAll(semver.MajorMinor(version), ".", "-")
+	if m.Architecture() == infrav1.ArchitectureARM64 {
+		image += "-" + string(infrav1.ArchitectureARM64)
+	}
 	sourceImage := path.Join("projects", m.ClusterGetter.Project(), "global", "images", "family", image)
 	if m.GCPMachine.Spec.Image != nil {
 		sourceImage = *m.GCPMachine.Spec.Image
 	} else if m.GCPMachine.Spec.ImageFamily != nil {
 		sourceImage = *m.GCPMachine.Spec.ImageFamily
+	} else if m.GCPMachine.Status.Image != nil {
+		sourceImage = *m.GCPMachine.Status.Image
 	}
 
 	diskType := infrav1.PdStandardDiskType
@@ -250,25 +363,7 @@ func (m *MachineScope) InstanceImageSpec() *compute.AttachedDisk {
 			SourceImage:         sourceImage,
 			Labels:              m.ClusterGetter.AdditionalLabels().AddLabels(m.GCPMachine.Spec.AdditionalLabels),
 		},
-	}
-
-	if m.GCPMachine.Spec.RootDiskEncryptionKey != nil {
-		if m.GCPMachine.Spec.RootDiskEncryptionKey.KeyType == infrav1.CustomerManagedKey && m.GCPMachine.Spec.RootDiskEncryptionKey.ManagedKey != nil {
-			disk.DiskEncryptionKey = &compute.CustomerEncryptionKey{
-				KmsKeyName: m.GCPMachine.Spec.RootDiskEncryptionKey.ManagedKey.KMSKeyName,
-			}
-			if m.GCPMachine.Spec.RootDiskEncryptionKey.KMSKeyServiceAccount != nil {
-				disk.DiskEncryptionKey.KmsKeyServiceAccount = *m.GCPMachine.Spec.RootDiskEncryptionKey.KMSKeyServiceAccount
-			}
-		} else if m.GCPMachine.Spec.RootDiskEncryptionKey.KeyType == infrav1.CustomerSuppliedKey && m.GCPMachine.Spec.RootDiskEncryptionKey.SuppliedKey != nil {
-			disk.DiskEncryptionKey = &compute.CustomerEncryptionKey{
-				RawKey:          string(m.GCPMachine.Spec.RootDiskEncryptionKey.SuppliedKey.RawKey),
-				RsaEncryptedKey: string(m.GCPMachine.Spec.RootDiskEncryptionKey.SuppliedKey.RSAEncryptedKey),
-			}
-			if m.GCPMachine.Spec.RootDiskEncryptionKey.KMSKeyServiceAccount != nil {
-				disk.DiskEncryptionKey.KmsKeyServiceAccount = *m.GCPMachine.Spec.RootDiskEncryptionKey.KMSKeyServiceAccount
-			}
-		}
+		DiskEncryptionKey: customerEncryptionKey(m.GCPMachine.Spec.RootDiskEncryptionKey),
 	}
 
 	return disk
@@ -278,13 +373,25 @@ func (m *MachineScope) InstanceImageSpec() *compute.AttachedDisk {
 func (m *MachineScope) InstanceAdditionalDiskSpec() []*compute.AttachedDisk {
 	additionalDisks := make([]*compute.AttachedDisk, 0, len(m.GCPMachine.Spec.AdditionalDisks))
 	for _, disk := range m.GCPMachine.Spec.AdditionalDisks {
+		diskSize := ptr.Deref(disk.Size, 30)
+		if disk.Size == nil && (disk.SourceImage != nil || disk.SourceSnapshot != nil) {
+			// Let GCP size the disk after its source.
+			diskSize = 0
+		}
 		additionalDisk := &compute.AttachedDisk{
-			AutoDelete: true,
+			AutoDelete: ptr.Deref(disk.RetainPolicy, infrav1.DiskRetainPolicyDelete) != infrav1.DiskRetainPolicyRetain,
 			InitializeParams: &compute.AttachedDiskInitializeParams{
-				DiskSizeGb:          ptr.Deref(disk.Size, 30),
-				DiskType:            path.Join("zones", m.Zone(), "diskTypes", string(*disk.DeviceType)),
-				ResourceManagerTags: shared.ResourceTagConvert(context.TODO(), m.GCPMachine.Spec.ResourceManagerTags),
+				DiskName:              m.additionalDiskName(disk),
+				DiskSizeGb:            diskSize,
+				DiskType:              path.Join("zones", m.Zone(), "diskTypes", string(ptr.Deref(disk.DeviceType, infrav1.PdStandardDiskType))),
+				ResourceManagerTags:   shared.ResourceTagConvert(context.TODO(), m.GCPMachine.Spec.ResourceManagerTags),
+				SourceImage:           ptr.Deref(disk.SourceImage, ""),
+				SourceSnapshot:        ptr.Deref(disk.SourceSnapshot, ""),
+				Labels:                disk.Labels,
+				ProvisionedIops:       ptr.Deref(disk.ProvisionedIops, 0),
+				ProvisionedThroughput: ptr.Deref(disk.ProvisionedThroughput, 0),
 			},
+			DiskEncryptionKey: customerEncryptionKey(disk.EncryptionKey),
 		}
 		if strings.HasSuffix(additionalDisk.InitializeParams.DiskType, string(infrav1.LocalSsdDiskType)) {
 			additionalDisk.Type = "SCRATCH" // Default is PERSISTENT.
@@ -296,24 +403,6 @@ func (m *MachineScope) InstanceAdditionalDiskSpec() []*compute.AttachedDisk {
 			// https://cloud.google.com/compute/docs/disks/local-ssd#choose_an_interface
 			additionalDisk.Interface = "NVME"
 		}
-		if disk.EncryptionKey != nil {
-			if m.GCPMachine.Spec.RootDiskEncryptionKey.KeyType == infrav1.CustomerManagedKey && m.GCPMachine.Spec.RootDiskEncryptionKey.ManagedKey != nil {
-				additionalDisk.DiskEncryptionKey = &compute.CustomerEncryptionKey{
-					KmsKeyName: m.GCPMachine.Spec.RootDiskEncryptionKey.ManagedKey.KMSKeyName,
-				}
-				if m.GCPMachine.Spec.RootDiskEncryptionKey.KMSKeyServiceAccount != nil {
-					additionalDisk.DiskEncryptionKey.KmsKeyServiceAccount = *m.GCPMachine.Spec.RootDiskEncryptionKey.KMSKeyServiceAccount
-				}
-			} else if m.GCPMachine.Spec.RootDiskEncryptionKey.KeyType == infrav1.CustomerSuppliedKey && m.GCPMachine.Spec.RootDiskEncryptionKey.SuppliedKey != nil {
-				additionalDisk.DiskEncryptionKey = &compute.CustomerEncryptionKey{
-					RawKey:          string(m.GCPMachine.Spec.RootDiskEncryptionKey.SuppliedKey.RawKey),
-					RsaEncryptedKey: string(m.GCPMachine.Spec.RootDiskEncryptionKey.SuppliedKey.RSAEncryptedKey),
-				}
-				if m.GCPMachine.Spec.RootDiskEncryptionKey.KMSKeyServiceAccount != nil {
-					additionalDisk.DiskEncryptionKey.KmsKeyServiceAccount = *m.GCPMachine.Spec.RootDiskEncryptionKey.KMSKeyServiceAccount
-				}
-			}
-		}
 
 		additionalDisks = append(additionalDisks, additionalDisk)
 	}
@@ -321,6 +410,77 @@ func (m *MachineScope) InstanceAdditionalDiskSpec() []*compute.AttachedDisk {
 	return additionalDisks
 }
 
+// additionalDiskName returns the name of an additional disk, named after the machine when a name suffix is set,
+// or an empty name to let GCP name the disk.
+func (m *MachineScope) additionalDiskName(disk infrav1.AttachedDiskSpec) string {
+	if disk.NameSuffix != nil {
+		return fmt.Sprintf("%s-%s", m.Name(), *disk.NameSuffix)
+	}
+
+	return ptr.Deref(disk.Name, "")
+}
+
+// customerEncryptionKey converts the encryption key of a disk to its compute API representation.
+func customerEncryptionKey(key *infrav1.CustomerEncryptionKey) *compute.CustomerEncryptionKey {
+	if key == nil {
+		return nil
+	}
+
+	var diskEncryptionKey *compute.CustomerEncryptionKey
+	switch {
+	case key.KeyType == infrav1.CustomerManagedKey && key.ManagedKey != nil:
+		diskEncryptionKey = &compute.CustomerEncryptionKey{
+			KmsKeyName: kmsKeyName(key.ManagedKey),
+		}
+	case key.KeyType == infrav1.CustomerSuppliedKey && key.SuppliedKey != nil:
+		// The compute API expects the supplied keys base64 encoded, while the spec holds the decoded bytes.
+		diskEncryptionKey = &compute.CustomerEncryptionKey{}
+		if len(key.SuppliedKey.RawKey) > 0 {
+			diskEncryptionKey.RawKey = base64.StdEncoding.EncodeToString(key.SuppliedKey.RawKey)
+		}
+		if len(key.SuppliedKey.RSAEncryptedKey) > 0 {
+			diskEncryptionKey.RsaEncryptedKey = base64.StdEncoding.EncodeToString(key.SuppliedKey.RSAEncryptedKey)
+		}
+	default:
+		return nil
+	}
+	if key.KMSKeyServiceAccount != nil {
+		diskEncryptionKey.KmsKeyServiceAccount = *key.KMSKeyServiceAccount
+	}
+
+	return diskEncryptionKey
+}
+
+// kmsKeyName returns the name of the KMS key, pinned to the key version when one is set.
+func kmsKeyName(key *infrav1.ManagedKey) string {
+	if key.KeyVersion == nil {
+		return key.KMSKeyName
+	}
+
+	return path.Join(key.KMSKeyName, "cryptoKeyVersions", *key.KeyVersion)
+}
+
+// InstanceEtcdDiskSpec returns compute instance etcd data attached-disk spec, or nil when the
+// machine is not part of the control plane or has no etcd disk.
+func (m *MachineScope) InstanceEtcdDiskSpec() *compute.AttachedDisk {
+	etcdDisk := m.GCPMachine.Spec.EtcdDisk
+	if etcdDisk == nil || !m.IsControlPlane() {
+		return nil
+	}
+
+	return &compute.AttachedDisk{
+		AutoDelete: true,
+		DeviceName: infrav1.EtcdDiskDeviceName,
+		InitializeParams: &compute.AttachedDiskInitializeParams{
+			DiskName:            fmt.Sprintf("%s-%s", m.Name(), infrav1.EtcdDiskDeviceName),
+			DiskSizeGb:          ptr.Deref(etcdDisk.Size, 50),
+			DiskType:            path.Join("zones", m.Zone(), "diskTypes", string(ptr.Deref(etcdDisk.DeviceType, infrav1.PdSsdDiskType))),
+			ResourceManagerTags: shared.ResourceTagConvert(context.TODO(), m.GCPMachine.Spec.ResourceManagerTags),
+			ProvisionedIops:     ptr.Deref(etcdDisk.ProvisionedIops, 0),
+		},
+	}
+}
+
 // InstanceNetworkInterfaceSpec returns compute network interface spec.
 func (m *MachineScope) InstanceNetworkInterfaceSpec() *compute.NetworkInterface {
 	networkInterface := &compute.NetworkInterface{
@@ -461,6 +621,13 @@ func (m *MachineScope) InstanceSpec(log logr.Logger) *compute.Instance {
 	instance.Disks = append(instance.Disks, m.InstanceImageSpec())
 	instance.Disks = append(instance.Disks, m.InstanceAdditionalDiskSpec()...)
 	instance.Metadata = m.InstanceAdditionalMetadataSpec()
+	if etcdDisk := m.InstanceEtcdDiskSpec(); etcdDisk != nil {
+		instance.Disks = append(instance.Disks, etcdDisk)
+		instance.Metadata.Items = append(instance.Metadata.Items, &compute.MetadataItems{
+			Key:   infrav1.EtcdDiskMetadataKey,
+			Value: ptr.To[string]("/dev/disk/by-id/google-" + infrav1.EtcdDiskDeviceName),
+		})
+	}
 	instance.ServiceAccounts = append(instance.ServiceAccounts, m.InstanceServiceAccountsSpec())
 	instance.NetworkInterfaces = append(instance.NetworkInterfaces, m.InstanceNetworkInterfaceSpec())
 	return instance
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/compute/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -72,3 +75,354 @@ func TestMachineLocalSSDDiskType(t *testing.T) {
 	assert.Equal(t, "NVME", localSSDTest.Interface)
 	assert.Equal(t, int64(375), localSSDTest.InitializeParams.DiskSizeGb)
 }
+
+// This test verifies that the etcd disk is only attached to control plane machines
+// and uses the well-known device name.
+func TestMachineEtcdDisk(t *testing.T) {
+	schema, err := infrav1.SchemeBuilder.Register(&infrav1.GCPMachine{}, &infrav1.GCPMachineList{}).Build()
+	assert.Nil(t, err)
+	testClient := fake.NewClientBuilder().WithScheme(schema).Build()
+
+	failureDomain := "us-central1-a"
+	diskSize := int64(100)
+	testGCPMachine := infrav1.GCPMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-machine",
+		},
+		Spec: infrav1.GCPMachineSpec{
+			EtcdDisk: &infrav1.EtcdDiskSpec{
+				Size: &diskSize,
+			},
+		},
+	}
+
+	// A worker machine does not get an etcd disk.
+	workerMachineScope, err := NewMachineScope(MachineScopeParams{
+		Client: testClient,
+		Machine: &clusterv1.Machine{
+			Spec: clusterv1.MachineSpec{
+				FailureDomain: &failureDomain,
+			},
+		},
+		GCPMachine: &testGCPMachine,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, workerMachineScope.InstanceEtcdDiskSpec())
+
+	controlPlaneMachineScope, err := NewMachineScope(MachineScopeParams{
+		Client: testClient,
+		Machine: &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					clusterv1.MachineControlPlaneLabel: "",
+				},
+			},
+			Spec: clusterv1.MachineSpec{
+				FailureDomain: &failureDomain,
+			},
+		},
+		GCPMachine: &testGCPMachine,
+	})
+	assert.Nil(t, err)
+
+	etcdDisk := controlPlaneMachineScope.InstanceEtcdDiskSpec()
+	assert.NotNil(t, etcdDisk)
+	assert.Equal(t, infrav1.EtcdDiskDeviceName, etcdDisk.DeviceName)
+	assert.Equal(t, "my-machine-etcd", etcdDisk.InitializeParams.DiskName)
+	assert.Equal(t, "zones/us-central1-a/diskTypes/pd-ssd", etcdDisk.InitializeParams.DiskType)
+	assert.Equal(t, int64(100), etcdDisk.InitializeParams.DiskSizeGb)
+}
+
+// This test verifies that additional disks with a name suffix are named after the machine.
+func TestMachineAdditionalDiskNameSuffix(t *testing.T) {
+	schema, err := infrav1.SchemeBuilder.Register(&infrav1.GCPMachine{}, &infrav1.GCPMachineList{}).Build()
+	assert.Nil(t, err)
+	testClient := fake.NewClientBuilder().WithScheme(schema).Build()
+
+	failureDomain := "us-central1-a"
+	nameSuffix := "data"
+	name := "shared-data"
+	retain := infrav1.DiskRetainPolicyRetain
+	testGCPMachine := infrav1.GCPMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-machine",
+		},
+		Spec: infrav1.GCPMachineSpec{
+			AdditionalDisks: []infrav1.AttachedDiskSpec{
+				{
+					NameSuffix:   &nameSuffix,
+					RetainPolicy: &retain,
+				},
+				{
+					Name: &name,
+				},
+				{},
+			},
+		},
+	}
+
+	testMachineScope, err := NewMachineScope(MachineScopeParams{
+		Client: testClient,
+		Machine: &clusterv1.Machine{
+			Spec: clusterv1.MachineSpec{
+				FailureDomain: &failureDomain,
+			},
+		},
+		GCPMachine: &testGCPMachine,
+	})
+	assert.Nil(t, err)
+
+	diskSpec := testMachineScope.InstanceAdditionalDiskSpec()
+	assert.Len(t, diskSpec, 3)
+	assert.Equal(t, "my-machine-data", diskSpec[0].InitializeParams.DiskName)
+	assert.False(t, diskSpec[0].AutoDelete)
+	assert.Equal(t, "shared-data", diskSpec[1].InitializeParams.DiskName)
+	assert.Empty(t, diskSpec[2].InitializeParams.DiskName)
+}
+
+// This test verifies that each additional disk is encrypted with its own key, even when
+// no root disk key is set, and that disks not using the pinned key version are reported.
+func TestMachineAdditionalDiskEncryptionKey(t *testing.T) {
+	schema, err := infrav1.SchemeBuilder.Register(&infrav1.GCPMachine{}, &infrav1.GCPMachineList{}).Build()
+	assert.Nil(t, err)
+	testClient := fake.NewClientBuilder().WithScheme(schema).Build()
+
+	failureDomain := "us-central1-a"
+	keyVersion := "2"
+	testGCPMachine := infrav1.GCPMachine{
+		Spec: infrav1.GCPMachineSpec{
+			AdditionalDisks: []infrav1.AttachedDiskSpec{
+				{
+					EncryptionKey: &infrav1.CustomerEncryptionKey{
+						KeyType: infrav1.CustomerManagedKey,
+						ManagedKey: &infrav1.ManagedKey{
+							KMSKeyName: "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key",
+							KeyVersion: &keyVersion,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testMachineScope, err := NewMachineScope(MachineScopeParams{
+		Client: testClient,
+		Machine: &clusterv1.Machine{
+			Spec: clusterv1.MachineSpec{
+				FailureDomain: &failureDomain,
+			},
+		},
+		GCPMachine: &testGCPMachine,
+	})
+	assert.Nil(t, err)
+
+	diskSpec := testMachineScope.InstanceAdditionalDiskSpec()
+	assert.Len(t, diskSpec, 1)
+	assert.NotNil(t, diskSpec[0].DiskEncryptionKey)
+	assert.Equal(t, "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key/cryptoKeyVersions/2", diskSpec[0].DiskEncryptionKey.KmsKeyName)
+
+	// The disk was encrypted with an older key version, e.g. a retained disk re-attached after a key rotation.
+	testMachineScope.SetDiskEncryptionKeys([]*compute.AttachedDisk{
+		{
+			DeviceName: "persistent-disk-0",
+		},
+		{
+			DeviceName: "persistent-disk-1",
+			DiskEncryptionKey: &compute.CustomerEncryptionKey{
+				KmsKeyName: "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key/cryptoKeyVersions/1",
+			},
+		},
+	}, nil)
+	assert.Len(t, testGCPMachine.Status.DiskEncryptionKeys, 1)
+	assert.Equal(t, "persistent-disk-1", testGCPMachine.Status.DiskEncryptionKeys[0].DeviceName)
+	assert.True(t, conditions.IsFalse(&testGCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition))
+	assert.Equal(t, infrav1.DiskEncryptionKeyVersionMismatchReason, conditions.GetReason(&testGCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition))
+}
+
+// This test verifies that disks encrypted with a key without a pinned version are compared with the
+// primary version of the key, and are reported as unknown when the primary version cannot be read.
+func TestMachineUnpinnedDiskEncryptionKey(t *testing.T) {
+	schema, err := infrav1.SchemeBuilder.Register(&infrav1.GCPMachine{}, &infrav1.GCPMachineList{}).Build()
+	assert.Nil(t, err)
+	testClient := fake.NewClientBuilder().WithScheme(schema).Build()
+
+	failureDomain := "us-central1-a"
+	keyName := "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key"
+	testGCPMachine := infrav1.GCPMachine{
+		Spec: infrav1.GCPMachineSpec{
+			AdditionalDisks: []infrav1.AttachedDiskSpec{
+				{
+					EncryptionKey: &infrav1.CustomerEncryptionKey{
+						KeyType: infrav1.CustomerManagedKey,
+						ManagedKey: &infrav1.ManagedKey{
+							KMSKeyName: keyName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testMachineScope, err := NewMachineScope(MachineScopeParams{
+		Client: testClient,
+		Machine: &clusterv1.Machine{
+			Spec: clusterv1.MachineSpec{
+				FailureDomain: &failureDomain,
+			},
+		},
+		GCPMachine: &testGCPMachine,
+	})
+	assert.Nil(t, err)
+
+	disks := []*compute.AttachedDisk{
+		{
+			DeviceName: "persistent-disk-0",
+		},
+		{
+			DeviceName: "persistent-disk-1",
+			DiskEncryptionKey: &compute.CustomerEncryptionKey{
+				KmsKeyName: keyName + "/cryptoKeyVersions/1",
+			},
+		},
+	}
+
+	// The primary version of the key is unknown, e.g. when it could not be read from Cloud KMS.
+	testMachineScope.SetDiskEncryptionKeys(disks, nil)
+	assert.Len(t, testGCPMachine.Status.DiskEncryptionKeys, 1)
+	assert.True(t, conditions.IsUnknown(&testGCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition))
+	assert.Equal(t, infrav1.DiskEncryptionKeyVersionUnknownReason, conditions.GetReason(&testGCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition))
+
+	// The disk is still encrypted with the primary version.
+	testMachineScope.SetDiskEncryptionKeys(disks, map[string]string{keyName: keyName + "/cryptoKeyVersions/1"})
+	assert.True(t, conditions.IsTrue(&testGCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition))
+
+	// The key was rotated since the disk was created.
+	testMachineScope.SetDiskEncryptionKeys(disks, map[string]string{keyName: keyName + "/cryptoKeyVersions/2"})
+	assert.True(t, conditions.IsFalse(&testGCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition))
+	assert.Equal(t, infrav1.DiskEncryptionKeyVersionMismatchReason, conditions.GetReason(&testGCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition))
+}
+
+// This test verifies that the instance disks are matched with the additional disks of the spec on their name
+// or device name, whatever the order they are returned in.
+func TestMachineDiskEncryptionKeysOrder(t *testing.T) {
+	schema, err := infrav1.SchemeBuilder.Register(&infrav1.GCPMachine{}, &infrav1.GCPMachineList{}).Build()
+	assert.Nil(t, err)
+	testClient := fake.NewClientBuilder().WithScheme(schema).Build()
+
+	failureDomain := "us-central1-a"
+	nameSuffix := "data"
+	keyName := "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key"
+	managedKey := func(version string) *infrav1.CustomerEncryptionKey {
+		return &infrav1.CustomerEncryptionKey{
+			KeyType:    infrav1.CustomerManagedKey,
+			ManagedKey: &infrav1.ManagedKey{KMSKeyName: keyName, KeyVersion: &version},
+		}
+	}
+	testGCPMachine := infrav1.GCPMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-machine"},
+		Spec: infrav1.GCPMachineSpec{
+			AdditionalDisks: []infrav1.AttachedDiskSpec{
+				{
+					NameSuffix:    &nameSuffix,
+					EncryptionKey: managedKey("1"),
+				},
+				{
+					EncryptionKey: managedKey("2"),
+				},
+			},
+		},
+	}
+
+	testMachineScope, err := NewMachineScope(MachineScopeParams{
+		Client: testClient,
+		Machine: &clusterv1.Machine{
+			Spec: clusterv1.MachineSpec{
+				FailureDomain: &failureDomain,
+			},
+		},
+		GCPMachine: &testGCPMachine,
+	})
+	assert.Nil(t, err)
+
+	testMachineScope.SetDiskEncryptionKeys([]*compute.AttachedDisk{
+		{
+			DeviceName: "persistent-disk-1",
+			Source:     "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/disks/my-machine-data",
+			DiskEncryptionKey: &compute.CustomerEncryptionKey{
+				KmsKeyName: keyName + "/cryptoKeyVersions/1",
+			},
+		},
+		{
+			DeviceName: "persistent-disk-2",
+			Source:     "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/disks/my-machine-2",
+			DiskEncryptionKey: &compute.CustomerEncryptionKey{
+				KmsKeyName: keyName + "/cryptoKeyVersions/2",
+			},
+		},
+		{
+			Boot:       true,
+			DeviceName: "persistent-disk-0",
+		},
+	}, nil)
+	assert.Len(t, testGCPMachine.Status.DiskEncryptionKeys, 2)
+	assert.True(t, conditions.IsTrue(&testGCPMachine, infrav1.DiskEncryptionKeysUpToDateCondition))
+}
+
+// This test verifies that customer-supplied keys are sent base64 encoded to the compute API, for the root disk
+// as well as for the additional disks.
+func TestMachineSuppliedDiskEncryptionKey(t *testing.T) {
+	schema, err := infrav1.SchemeBuilder.Register(&infrav1.GCPMachine{}, &infrav1.GCPMachineList{}).Build()
+	assert.Nil(t, err)
+	testClient := fake.NewClientBuilder().WithScheme(schema).Build()
+
+	failureDomain := "us-central1-a"
+	testGCPMachine := infrav1.GCPMachine{
+		Spec: infrav1.GCPMachineSpec{
+			RootDiskEncryptionKey: &infrav1.CustomerEncryptionKey{
+				KeyType: infrav1.CustomerSuppliedKey,
+				SuppliedKey: &infrav1.SuppliedKey{
+					RawKey: []byte("Hello from Google Cloud Platform"),
+				},
+			},
+			AdditionalDisks: []infrav1.AttachedDiskSpec{
+				{
+					EncryptionKey: &infrav1.CustomerEncryptionKey{
+						KeyType: infrav1.CustomerSuppliedKey,
+						SuppliedKey: &infrav1.SuppliedKey{
+							RSAEncryptedKey: []byte("RSA wrapped customer-supplied key"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testMachineScope, err := NewMachineScope(MachineScopeParams{
+		Client: testClient,
+		ClusterGetter: &ClusterScope{
+			GCPCluster: &infrav1.GCPCluster{
+				Spec: infrav1.GCPClusterSpec{
+					Project: "my-project",
+				},
+			},
+		},
+		Machine: &clusterv1.Machine{
+			Spec: clusterv1.MachineSpec{
+				FailureDomain: &failureDomain,
+			},
+		},
+		GCPMachine: &testGCPMachine,
+	})
+	assert.Nil(t, err)
+
+	rootDisk := testMachineScope.InstanceImageSpec()
+	assert.NotNil(t, rootDisk.DiskEncryptionKey)
+	assert.Equal(t, "SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0=", rootDisk.DiskEncryptionKey.RawKey)
+	assert.Empty(t, rootDisk.DiskEncryptionKey.RsaEncryptedKey)
+
+	diskSpec := testMachineScope.InstanceAdditionalDiskSpec()
+	assert.Len(t, diskSpec, 1)
+	assert.NotNil(t, diskSpec[0].DiskEncryptionKey)
+	assert.Equal(t, "UlNBIHdyYXBwZWQgY3VzdG9tZXItc3VwcGxpZWQga2V5", diskSpec[0].DiskEncryptionKey.RsaEncryptedKey)
+	assert.Empty(t, diskSpec[0].DiskEncryptionKey.RawKey)
+}
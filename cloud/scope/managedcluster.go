@@ -22,6 +22,7 @@ import (
 	"strconv"
 
 	"github.com/pkg/errors"
+	"google.golang.org/api/cloudkms/v1"
 	"google.golang.org/api/compute/v1"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
@@ -98,6 +99,25 @@ func (s *ManagedClusterScope) NetworkCloud() cloud.Cloud {
 	return newCloud(s.NetworkProject(), s.GCPServices)
 }
 
+// ComputeService returns the compute service, for the APIs the cloud does not provide.
+func (s *ManagedClusterScope) ComputeService() *compute.Service {
+	return s.GCPServices.Compute
+}
+
+// KMSService returns the Cloud KMS service, creating it on first use.
+func (s *ManagedClusterScope) KMSService(ctx context.Context) (*cloudkms.Service, error) {
+	if s.GCPServices.KMS == nil {
+		kmsSvc, err := newKMSService(ctx, s.GCPManagedCluster.Spec.CredentialsRef, s.client)
+		if err != nil {
+			return nil, err
+		}
+
+		s.GCPServices.KMS = kmsSvc
+	}
+
+	return s.GCPServices.KMS, nil
+}
+
 // Project returns the current project name.
 func (s *ManagedClusterScope) Project() string {
 	return s.GCPManagedCluster.Spec.Project
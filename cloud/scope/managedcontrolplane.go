@@ -28,6 +28,7 @@ import (
 	credentials "cloud.google.com/go/iam/credentials/apiv1"
 	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
 	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	clusterv1exp "sigs.k8s.io/cluster-api/exp/api/v1beta1"
@@ -45,6 +46,7 @@ type ManagedControlPlaneScopeParams struct {
 	CredentialsClient      *credentials.IamCredentialsClient
 	ManagedClusterClient   *container.ClusterManagerClient
 	TagBindingsClient      *resourcemanager.TagBindingsClient
+	GKEHubService          *gkehub.Service
 	Client                 client.Client
 	Cluster                *clusterv1.Cluster
 	GCPManagedCluster      *infrav1exp.GCPManagedCluster
@@ -83,6 +85,13 @@ func NewManagedControlPlaneScope(ctx context.Context, params ManagedControlPlane
 		}
 		params.TagBindingsClient = tagBindingsClient
 	}
+	if params.GKEHubService == nil {
+		gkeHubService, err := newGKEHubService(ctx, params.GCPManagedCluster.Spec.CredentialsRef, params.Client, params.GCPManagedCluster.Spec.ServiceEndpoints)
+		if err != nil {
+			return nil, errors.Errorf("failed to create gcp gke hub service: %v", err)
+		}
+		params.GKEHubService = gkeHubService
+	}
 	if params.CredentialsClient == nil {
 		var credentialsClient *credentials.IamCredentialsClient
 		credentialsClient, err = newIamCredentialsClient(ctx, params.GCPManagedCluster.Spec.CredentialsRef, params.Client, params.GCPManagedCluster.Spec.ServiceEndpoints)
@@ -104,6 +113,7 @@ func NewManagedControlPlaneScope(ctx context.Context, params ManagedControlPlane
 		GCPManagedControlPlane: params.GCPManagedControlPlane,
 		mcClient:               params.ManagedClusterClient,
 		tagBindingsClient:      params.TagBindingsClient,
+		gkeHubService:          params.GKEHubService,
 		credentialsClient:      params.CredentialsClient,
 		credential:             credential,
 		patchHelper:            helper,
@@ -120,6 +130,7 @@ type ManagedControlPlaneScope struct {
 	GCPManagedControlPlane *infrav1exp.GCPManagedControlPlane
 	mcClient               *container.ClusterManagerClient
 	tagBindingsClient      *resourcemanager.TagBindingsClient
+	gkeHubService          *gkehub.Service
 	credentialsClient      *credentials.IamCredentialsClient
 	credential             *Credential
 
@@ -168,6 +179,11 @@ func (s *ManagedControlPlaneScope) TagBindingsClient() *resourcemanager.TagBindi
 	return s.tagBindingsClient
 }
 
+// GKEHubService returns a service for the GKE Hub API.
+func (s *ManagedControlPlaneScope) GKEHubService() *gkehub.Service {
+	return s.gkeHubService
+}
+
 // CredentialsClient returns a client used to interact with IAM.
 func (s *ManagedControlPlaneScope) CredentialsClient() *credentials.IamCredentialsClient {
 	return s.credentialsClient
@@ -220,11 +236,21 @@ func (s *ManagedControlPlaneScope) ClusterFullName() string {
 	return fmt.Sprintf("%s/clusters/%s", s.ClusterLocation(), s.GCPManagedControlPlane.Spec.ClusterName)
 }
 
+// OperationFullName returns the full name of a GKE operation in the location of the cluster.
+func (s *ManagedControlPlaneScope) OperationFullName(operation string) string {
+	return fmt.Sprintf("%s/operations/%s", s.ClusterLocation(), operation)
+}
+
 // ClusterName returns the name of the cluster.
 func (s *ManagedControlPlaneScope) ClusterName() string {
 	return s.GCPManagedControlPlane.Spec.ClusterName
 }
 
+// ClusterResourceLabels returns the resource labels of the GKE cluster.
+func (s *ManagedControlPlaneScope) ClusterResourceLabels() map[string]string {
+	return NodePoolResourceLabels(s.GCPManagedCluster.Spec.AdditionalLabels, s.ClusterName())
+}
+
 // SetEndpoint sets the Endpoint of GCPManagedControlPlane.
 func (s *ManagedControlPlaneScope) SetEndpoint(host string) {
 	s.GCPManagedControlPlane.Spec.Endpoint = clusterv1.APIEndpoint{
@@ -23,7 +23,7 @@ import (
 
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
-	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/shared"
 	"sigs.k8s.io/cluster-api-provider-gcp/util/location"
 
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -166,15 +166,17 @@ func NodePoolResourceLabels(additionalLabels infrav1.Labels, clusterName string)
 	return resourceLabels
 }
 
+// IsOwnedResource returns true if the resource labels mark the resource as created by Cluster API for the cluster.
+func IsOwnedResource(resourceLabels map[string]string, clusterName string) bool {
+	return resourceLabels[infrav1.ClusterTagKey(clusterName)] == string(infrav1.ResourceLifecycleOwned)
+}
+
 // ConvertToSdkNodePool converts a node pool to format that is used by GCP SDK.
 func ConvertToSdkNodePool(nodePool infrav1exp.GCPManagedMachinePool, machinePool clusterv1exp.MachinePool, regional bool, clusterName string) *containerpb.NodePool {
-	replicas := *machinePool.Spec.Replicas
-	if regional {
-		if len(nodePool.Spec.NodeLocations) != 0 {
-			replicas /= int32(len(nodePool.Spec.NodeLocations))
-		} else {
-			replicas /= cloud.DefaultNumRegionsPerZone
-		}
+	// The replicas of the machine pool are checked to be spread evenly across the zones before creation.
+	replicas := *machinePool.Spec.Replicas / shared.NodePoolZoneCount(&nodePool, regional)
+	if nodePool.Spec.ReplicasPerZone != nil {
+		replicas = *nodePool.Spec.ReplicasPerZone
 	}
 	nodePoolName := nodePool.Spec.NodePoolName
 	if len(nodePoolName) == 0 {
@@ -264,6 +266,50 @@ func ConvertToSdkNodePool(nodePool infrav1exp.GCPManagedMachinePool, machinePool
 		}
 	}
 
+	if nodePool.Spec.Spot {
+		sdkNodePool.Config.Spot = true
+	}
+	if nodePool.Spec.Preemptible {
+		sdkNodePool.Config.Preemptible = true
+	}
+	if len(nodePool.Spec.Accelerators) != 0 {
+		sdkNodePool.Config.Accelerators = infrav1exp.ConvertToSdkAccelerators(nodePool.Spec.Accelerators)
+	}
+	if nodePool.Spec.ReservationAffinity != nil {
+		sdkNodePool.Config.ReservationAffinity = infrav1exp.ConvertToSdkReservationAffinity(nodePool.Spec.ReservationAffinity)
+	}
+	if nodePool.Spec.BootDiskKMSKey != nil {
+		sdkNodePool.Config.BootDiskKmsKey = *nodePool.Spec.BootDiskKMSKey
+	}
+	if nodePool.Spec.KubeletConfig != nil {
+		sdkNodePool.Config.KubeletConfig = infrav1exp.ConvertToSdkKubeletConfig(nodePool.Spec.KubeletConfig)
+	}
+	if len(nodePool.Spec.Metadata) != 0 {
+		sdkNodePool.Config.Metadata = nodePool.Spec.Metadata
+	}
+	if nodePool.Spec.WorkloadMetadataMode != nil {
+		sdkNodePool.Config.WorkloadMetadataConfig = infrav1exp.ConvertToSdkWorkloadMetadataConfig(nodePool.Spec.WorkloadMetadataMode)
+	}
+	if nodePool.Spec.EnableGvnic != nil {
+		sdkNodePool.Config.Gvnic = &containerpb.VirtualNIC{
+			Enabled: *nodePool.Spec.EnableGvnic,
+		}
+	}
+	if nodePool.Spec.EnableConfidentialNodes != nil {
+		sdkNodePool.Config.ConfidentialNodes = &containerpb.ConfidentialNodes{
+			Enabled: *nodePool.Spec.EnableConfidentialNodes,
+		}
+	}
+	if len(nodePool.Spec.ResourceManagerTags) != 0 {
+		sdkNodePool.Config.ResourceManagerTags = &containerpb.ResourceManagerTags{
+			Tags: nodePool.Spec.ResourceManagerTags,
+		}
+	}
+
+	if nodePool.Spec.UpgradeSettings != nil {
+		sdkNodePool.UpgradeSettings = infrav1exp.ConvertToSdkUpgradeSettings(nodePool.Spec.UpgradeSettings)
+	}
+
 	if ptr.Deref(nodePool.Spec.NodeSecurity.SandboxType, "") == "GVISOR" {
 		sdkNodePool.Config.SandboxConfig = &containerpb.SandboxConfig{
 			Type: containerpb.SandboxConfig_GVISOR,
@@ -312,3 +358,8 @@ func (s *ManagedMachinePoolScope) NodePoolLocation() string {
 func (s *ManagedMachinePoolScope) NodePoolFullName() string {
 	return fmt.Sprintf("%s/nodePools/%s", s.NodePoolLocation(), s.NodePoolName())
 }
+
+// OperationFullName returns the full name of a GKE operation in the location of the node pool.
+func (s *ManagedMachinePoolScope) OperationFullName(operation string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/operations/%s", s.GCPManagedControlPlane.Spec.Project, s.Region(), operation)
+}
@@ -4,7 +4,9 @@ import (
 	"cloud.google.com/go/container/apiv1/containerpb"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
 	"sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
@@ -52,6 +54,14 @@ var _ = Describe("GCPManagedMachinePool Scope", func() {
 		})
 	})
 
+	Context("Test IsOwnedResource", func() {
+		It("should only report resources with the cluster owned label as owned", func() {
+			Expect(IsOwnedResource(NodePoolResourceLabels(nil, TestClusterName), TestClusterName)).To(BeTrue())
+			Expect(IsOwnedResource(NodePoolResourceLabels(nil, "other-cluster"), TestClusterName)).To(BeFalse())
+			Expect(IsOwnedResource(map[string]string{"test-key": "test-value"}, TestClusterName)).To(BeFalse())
+		})
+	})
+
 	Context("Test ConvertToSdkNodePool", func() {
 		It("should convert to SDK node pool with default values", func() {
 			sdkNodePool := ConvertToSdkNodePool(*TestGCPMMP, *TestMP, false, TestClusterName)
@@ -82,6 +92,26 @@ var _ = Describe("GCPManagedMachinePool Scope", func() {
 			}))
 		})
 
+		It("should convert to SDK node pool with explicit replicas per zone", func() {
+			replicas := int32(5)
+			TestMP.Spec.Replicas = &replicas
+			TestGCPMMP.Spec.ReplicasPerZone = ptr.To(int32(2))
+
+			sdkNodePool := ConvertToSdkNodePool(*TestGCPMMP, *TestMP, true, TestClusterName)
+
+			Expect(sdkNodePool.GetInitialNodeCount()).To(Equal(int32(2)))
+		})
+
+		It("should convert to SDK node pool node count in a zonal cluster with node locations", func() {
+			replicas := int32(4)
+			TestMP.Spec.Replicas = &replicas
+			TestGCPMMP.Spec.NodeLocations = []string{"us-central1-a", "us-central1-b"}
+
+			sdkNodePool := ConvertToSdkNodePool(*TestGCPMMP, *TestMP, false, TestClusterName)
+
+			Expect(sdkNodePool.GetInitialNodeCount()).To(Equal(int32(2)))
+		})
+
 		It("should convert to SDK node pool using GCPManagedMachinePool", func() {
 			machineType := "n1-standard-1"
 			diskSizeGb := int32(128)
@@ -136,5 +166,66 @@ var _ = Describe("GCPManagedMachinePool Scope", func() {
 				},
 			}))
 		})
+
+		It("should convert to SDK node pool with node config", func() {
+			driverVersion := containerpb.GPUDriverInstallationConfig_LATEST
+			TestGCPMMP.Spec.Spot = true
+			TestGCPMMP.Spec.Accelerators = []v1beta1.AcceleratorConfig{
+				{
+					Type:             "nvidia-tesla-t4",
+					Count:            2,
+					GPUDriverVersion: ptr.To(v1beta1.GPUDriverVersionLatest),
+				},
+			}
+			TestGCPMMP.Spec.ReservationAffinity = &v1beta1.ReservationAffinity{
+				ConsumeReservationType: v1beta1.AnyReservation,
+			}
+			TestGCPMMP.Spec.BootDiskKMSKey = ptr.To("projects/my-project/locations/us-central1/keyRings/gke/cryptoKeys/boot")
+			TestGCPMMP.Spec.KubeletConfig = &v1beta1.KubeletConfig{
+				CPUManagerPolicy: ptr.To("static"),
+				CPUCFSQuota:      ptr.To(false),
+				PodPidsLimit:     ptr.To(int64(4096)),
+			}
+			TestGCPMMP.Spec.Metadata = map[string]string{"disable-legacy-endpoints": "true"}
+			TestGCPMMP.Spec.WorkloadMetadataMode = ptr.To(v1beta1.GKEMetadata)
+			TestGCPMMP.Spec.EnableGvnic = ptr.To(true)
+			TestGCPMMP.Spec.EnableConfidentialNodes = ptr.To(true)
+			TestGCPMMP.Spec.ResourceManagerTags = map[string]string{"tagKeys/123": "tagValues/456"}
+
+			sdkNodePool := ConvertToSdkNodePool(*TestGCPMMP, *TestMP, false, TestClusterName)
+
+			Expect(sdkNodePool.GetConfig()).To(Equal(&containerpb.NodeConfig{
+				ResourceLabels:         NodePoolResourceLabels(nil, TestClusterName),
+				ShieldedInstanceConfig: &containerpb.ShieldedInstanceConfig{},
+				Spot:                   true,
+				Accelerators: []*containerpb.AcceleratorConfig{
+					{
+						AcceleratorType:  "nvidia-tesla-t4",
+						AcceleratorCount: 2,
+						GpuDriverInstallationConfig: &containerpb.GPUDriverInstallationConfig{
+							GpuDriverVersion: &driverVersion,
+						},
+					},
+				},
+				ReservationAffinity: &containerpb.ReservationAffinity{
+					ConsumeReservationType: containerpb.ReservationAffinity_ANY_RESERVATION,
+				},
+				BootDiskKmsKey: "projects/my-project/locations/us-central1/keyRings/gke/cryptoKeys/boot",
+				KubeletConfig: &containerpb.NodeKubeletConfig{
+					CpuManagerPolicy: "static",
+					CpuCfsQuota:      wrapperspb.Bool(false),
+					PodPidsLimit:     4096,
+				},
+				Metadata: map[string]string{"disable-legacy-endpoints": "true"},
+				WorkloadMetadataConfig: &containerpb.WorkloadMetadataConfig{
+					Mode: containerpb.WorkloadMetadataConfig_GKE_METADATA,
+				},
+				Gvnic:             &containerpb.VirtualNIC{Enabled: true},
+				ConfidentialNodes: &containerpb.ConfidentialNodes{Enabled: true},
+				ResourceManagerTags: &containerpb.ResourceManagerTags{
+					Tags: map[string]string{"tagKeys/123": "tagValues/456"},
+				},
+			}))
+		})
 	})
 })
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instances
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/compute/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// primaryKeyVersionTTL is how long the primary versions of Cloud KMS keys are cached, which bounds how long a key
+// rotation goes unnoticed.
+const primaryKeyVersionTTL = 10 * time.Minute
+
+// primaryKeyVersions looks up the primary version of the Cloud KMS keys the machine disks are encrypted with
+// without a pinned version, keyed by key name. Keys whose primary version cannot be read are left out, so that
+// a missing cloudkms.cryptoKeys.get permission does not block the reconciliation of the instance; the disks using
+// them are reported through the DiskEncryptionKeysUpToDate condition instead.
+func (s *Service) primaryKeyVersions(ctx context.Context) map[string]string {
+	log := log.FromContext(ctx)
+
+	disks := append([]*compute.AttachedDisk{s.scope.InstanceImageSpec()}, s.scope.InstanceAdditionalDiskSpec()...)
+	primaryKeyVersions := map[string]string{}
+	for _, disk := range disks {
+		if disk.DiskEncryptionKey == nil || disk.DiskEncryptionKey.KmsKeyName == "" {
+			continue
+		}
+		name := disk.DiskEncryptionKey.KmsKeyName
+		if _, ok := primaryKeyVersions[name]; ok || strings.Contains(name, "/cryptoKeyVersions/") {
+			continue
+		}
+
+		version, ok := s.keyVersionCache.get(name)
+		if !ok {
+			cryptoKey, err := s.cryptoKeys.Get(ctx, name)
+			if err != nil {
+				// Failed lookups are cached as well, so that the error is only logged once per TTL.
+				log.V(2).Info("Unable to look up the primary version of the disk encryption key", "key", name, "error", err.Error())
+			} else if cryptoKey.Primary != nil {
+				version = cryptoKey.Primary.Name
+			}
+			s.keyVersionCache.set(name, version)
+		}
+		if version != "" {
+			primaryKeyVersions[name] = version
+		}
+	}
+
+	return primaryKeyVersions
+}
+
+// primaryKeyVersionCache caches the primary versions of Cloud KMS keys by key name, as every machine using a key
+// would otherwise look it up on each reconcile. Keys whose primary version is unknown are cached with an empty
+// version.
+type primaryKeyVersionCache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	items map[string]primaryKeyVersion
+}
+
+type primaryKeyVersion struct {
+	name      string
+	expiresAt time.Time
+}
+
+var defaultPrimaryKeyVersionCache = newPrimaryKeyVersionCache(primaryKeyVersionTTL)
+
+func newPrimaryKeyVersionCache(ttl time.Duration) *primaryKeyVersionCache {
+	return &primaryKeyVersionCache{
+		ttl:   ttl,
+		items: map[string]primaryKeyVersion{},
+	}
+}
+
+func (c *primaryKeyVersionCache) get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	version, ok := c.items[key]
+	if !ok || time.Now().After(version.expiresAt) {
+		return "", false
+	}
+
+	return version.name, true
+}
+
+func (c *primaryKeyVersionCache) set(key, version string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = primaryKeyVersion{name: version, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// cryptoKeysClient gets crypto keys with the Cloud KMS service of the scope, which is only created on first use.
+type cryptoKeysClient struct {
+	scope Scope
+}
+
+func (c *cryptoKeysClient) Get(ctx context.Context, name string) (*cloudkms.CryptoKey, error) {
+	service, err := c.scope.KMSService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.Projects.Locations.KeyRings.CryptoKeys.Get(name).Context(ctx).Do()
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instances
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudkms/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+)
+
+type fakeCryptoKeys struct {
+	items map[string]*cloudkms.CryptoKey
+	calls int
+}
+
+func (f *fakeCryptoKeys) Get(_ context.Context, name string) (*cloudkms.CryptoKey, error) {
+	f.calls++
+	cryptoKey, ok := f.items[name]
+	if !ok {
+		return nil, errors.New("permission denied")
+	}
+
+	return cryptoKey, nil
+}
+
+func TestService_primaryKeyVersions(t *testing.T) {
+	const (
+		keyName      = "projects/my-proj/locations/us-central1/keyRings/my-ring/cryptoKeys/my-key"
+		otherKeyName = "projects/my-proj/locations/us-central1/keyRings/my-ring/cryptoKeys/other-key"
+	)
+
+	machineScope := newPreflightMachineScope(t)
+	machineScope.GCPMachine.Spec.RootDiskEncryptionKey = &infrav1.CustomerEncryptionKey{
+		KeyType:    infrav1.CustomerManagedKey,
+		ManagedKey: &infrav1.ManagedKey{KMSKeyName: keyName},
+	}
+	machineScope.GCPMachine.Spec.AdditionalDisks = []infrav1.AttachedDiskSpec{
+		{
+			EncryptionKey: &infrav1.CustomerEncryptionKey{
+				KeyType:    infrav1.CustomerManagedKey,
+				ManagedKey: &infrav1.ManagedKey{KMSKeyName: keyName},
+			},
+		},
+		{
+			// Pinned versions are known without looking up the key.
+			EncryptionKey: &infrav1.CustomerEncryptionKey{
+				KeyType:    infrav1.CustomerManagedKey,
+				ManagedKey: &infrav1.ManagedKey{KMSKeyName: keyName, KeyVersion: ptr.To("1")},
+			},
+		},
+		{
+			EncryptionKey: &infrav1.CustomerEncryptionKey{
+				KeyType:    infrav1.CustomerManagedKey,
+				ManagedKey: &infrav1.ManagedKey{KMSKeyName: otherKeyName},
+			},
+		},
+	}
+
+	cryptoKeys := &fakeCryptoKeys{items: map[string]*cloudkms.CryptoKey{
+		keyName: {Name: keyName, Primary: &cloudkms.CryptoKeyVersion{Name: keyName + "/cryptoKeyVersions/2"}},
+	}}
+	cache := newPrimaryKeyVersionCache(primaryKeyVersionTTL)
+	s := New(machineScope)
+	s.cryptoKeys = cryptoKeys
+	s.keyVersionCache = cache
+
+	// The primary version of the other key cannot be read, so its disks are not compared.
+	want := map[string]string{keyName: keyName + "/cryptoKeyVersions/2"}
+	if d := cmp.Diff(want, s.primaryKeyVersions(context.TODO())); d != "" {
+		t.Errorf("primaryKeyVersions() mismatch (-want +got):\n%s", d)
+	}
+	if cryptoKeys.calls != 2 {
+		t.Errorf("primaryKeyVersions() looked up keys %d times, want once per unpinned key", cryptoKeys.calls)
+	}
+
+	// Later reconciles use the cached versions, including the failed lookup.
+	if d := cmp.Diff(want, s.primaryKeyVersions(context.TODO())); d != "" {
+		t.Errorf("primaryKeyVersions() mismatch (-want +got):\n%s", d)
+	}
+	if cryptoKeys.calls != 2 {
+		t.Errorf("primaryKeyVersions() looked up keys %d times, want the cached versions to be used", cryptoKeys.calls)
+	}
+
+	cache.ttl = 0
+	cache.set(keyName, keyName+"/cryptoKeyVersions/2")
+	if _, ok := cache.get(keyName); ok {
+		t.Errorf("primaryKeyVersionCache.get() returned an expired version")
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instances
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	k8scloud "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// resolveImage looks up the boot image of the machine for the given architecture when an image lookup is configured
+// and records the self-link of the selected image in the machine status.
+func (s *Service) resolveImage(ctx context.Context, architecture infrav1.Architecture) error {
+	lookup := s.scope.ImageLookup()
+	if lookup == nil {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+	project := ptr.Deref(lookup.Project, s.scope.Project())
+	labels, err := imageLookupLabels(lookup, s.scope.KubernetesVersion())
+	if err != nil {
+		return err
+	}
+	log.V(2).Info("Looking up image", "project", project, "labels", labels, "architecture", architecture)
+	image, err := s.lookupImage(ctx, project, labels, architecture)
+	if err != nil {
+		return err
+	}
+
+	log.V(2).Info("Found image", "image", image.SelfLink)
+	s.scope.SetImage(image.SelfLink)
+	return nil
+}
+
+// lookupImage returns the newest non-deprecated image in the project built for the given architecture and
+// carrying all the given labels. Results are cached for the lifetime of the service.
+func (s *Service) lookupImage(ctx context.Context, project string, labels map[string]string, architecture infrav1.Architecture) (*compute.Image, error) {
+	fl := imageLabelsFilter(labels)
+	cacheKey := project + "/" + string(architecture) + "/" + fl.String()
+	if image, ok := s.imageCache[cacheKey]; ok {
+		return image, nil
+	}
+
+	images, err := s.images.List(ctx, fl, k8scloud.ForceProjectID(project))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list images in project %s", project)
+	}
+
+	candidates := make([]*compute.Image, 0, len(images))
+	for _, image := range images {
+		if isImageDeprecated(image) || !hasImageLabels(image, labels) || !hasImageArchitecture(image, architecture) {
+			continue
+		}
+		candidates = append(candidates, image)
+	}
+	if len(candidates) == 0 {
+		return nil, errors.Errorf("no %s image found in project %s with labels %v", architecture, project, labels)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return imageCreationTime(candidates[i]).After(imageCreationTime(candidates[j]))
+	})
+
+	s.imageCache[cacheKey] = candidates[0]
+	return candidates[0], nil
+}
+
+// imageLookupLabels returns the labels an image must carry to satisfy the lookup. It returns an error if
+// the lookup has no labels to select an image with, rather than matching any image in the project.
+func imageLookupLabels(lookup *infrav1.ImageLookup, version string) (map[string]string, error) {
+	labels := make(map[string]string, len(lookup.Labels)+1)
+	for k, v := range lookup.Labels {
+		labels[k] = v
+	}
+
+	versionLabel := ptr.Deref(lookup.KubernetesVersionLabel, infrav1.DefaultImageLookupKubernetesVersionLabel)
+	if versionLabel != "" && version != "" {
+		labels[versionLabel] = strings.ReplaceAll(version, ".", "-")
+	}
+	if len(labels) == 0 {
+		return nil, errors.New("image lookup requires at least one label or a Kubernetes version to select an image")
+	}
+
+	return labels, nil
+}
+
+// imageLabelsFilter builds a filter matching images carrying all the given labels.
+func imageLabelsFilter(labels map[string]string) *filter.F {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fl := &filter.F{}
+	for _, k := range keys {
+		fl = fl.AndRegexp(fmt.Sprintf("labels.%s", k), regexp.QuoteMeta(labels[k]))
+	}
+
+	return fl
+}
+
+func hasImageLabels(image *compute.Image, labels map[string]string) bool {
+	for k, v := range labels {
+		if image.Labels[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// machineTypeArchitecture returns the CPU architecture the Compute API reports for the machine type, or the given
+// architecture when the machine type or its architecture is unknown, e.g. for custom machine types.
+func machineTypeArchitecture(machineType *compute.MachineType, architecture infrav1.Architecture) infrav1.Architecture {
+	if machineType == nil {
+		return architecture
+	}
+	switch machineType.Architecture {
+	case "ARM64":
+		return infrav1.ArchitectureARM64
+	case "X86_64":
+		return infrav1.ArchitectureAMD64
+	default:
+		return architecture
+	}
+}
+
+// hasImageArchitecture returns true if the image is built for the given architecture. Images that do not
+// specify an architecture are assumed to be x86-64.
+func hasImageArchitecture(image *compute.Image, architecture infrav1.Architecture) bool {
+	switch image.Architecture {
+	case "ARM64":
+		return architecture == infrav1.ArchitectureARM64
+	case "X86_64", "":
+		return architecture == infrav1.ArchitectureAMD64
+	default:
+		return false
+	}
+}
+
+func isImageDeprecated(image *compute.Image) bool {
+	return image.Deprecated != nil && image.Deprecated.State != "" && image.Deprecated.State != "ACTIVE"
+}
+
+func imageCreationTime(image *compute.Image) time.Time {
+	t, err := time.Parse(time.RFC3339, image.CreationTimestamp)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
@@ -0,0 +1,400 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instances
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	k8scloud "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// zoneCapabilitiesTTL is how long the machine types and disk types available in a zone are cached.
+const zoneCapabilitiesTTL = time.Hour
+
+var (
+	// sourceImageRegexp matches image and image family references, optionally qualified with a project or a URL.
+	sourceImageRegexp = regexp.MustCompile(`(?:^|/)(?:projects/([^/]+)/)?global/images/(?:(family)/)?([^/]+)$`)
+	// subnetworkRegexp matches the subnetwork references of the instance network interfaces.
+	subnetworkRegexp = regexp.MustCompile(`(?:^|/)projects/([^/]+)/regions/([^/]+)/subnetworks/([^/]+)$`)
+)
+
+// PreflightError reports the checks the instance of a machine failed before it was created.
+type PreflightError struct {
+	Failures []string
+}
+
+func (e *PreflightError) Error() string {
+	return strings.Join(e.Failures, "; ")
+}
+
+// Preflight checks the instance of the machine against the Compute API before it is created: the machine type,
+// disk types, boot image, subnetworks and service accounts must be available in the project and zone of the
+// machine, and the disk names must be valid. Failed checks are returned as a PreflightError, so that no instance is created until the spec is fixed.
+// Machines whose instance already exists pass without checks.
+func (s *Service) Preflight(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	instanceName := s.scope.Name()
+	zone := s.scope.Zone()
+	if _, err := s.instances.Get(ctx, meta.ZonalKey(instanceName, zone)); !gcperrors.IsNotFound(err) {
+		return err
+	}
+
+	log.V(2).Info("Running pre-flight checks for instance", "name", instanceName, "zone", zone)
+	instance := s.scope.InstanceSpec(log)
+	capabilities, err := s.zoneCapabilities(ctx, s.scope.Project(), zone)
+	if err != nil {
+		return err
+	}
+
+	var failures []string
+	machineType, machineTypeFailures := checkMachineType(instance, capabilities, zone)
+	failures = append(failures, machineTypeFailures...)
+
+	// The image is looked up for the architecture the Compute API reports for the machine type, which also covers
+	// machine series unknown to this version of the provider.
+	if s.scope.ImageLookup() != nil {
+		if err := s.resolveImage(ctx, machineTypeArchitecture(machineType, s.scope.Architecture())); err != nil {
+			return err
+		}
+		instance = s.scope.InstanceSpec(log)
+	}
+	failures = append(failures, checkDiskTypes(instance, capabilities, zone)...)
+	failures = append(failures, checkDiskNames(instance)...)
+
+	imageFailures, err := s.checkImage(ctx, instance, machineType)
+	if err != nil {
+		return err
+	}
+	failures = append(failures, imageFailures...)
+
+	subnetworkFailures, err := s.checkSubnetworks(ctx, instance, zone)
+	if err != nil {
+		return err
+	}
+	failures = append(failures, subnetworkFailures...)
+
+	serviceAccountFailures, err := s.checkServiceAccounts(ctx, instance)
+	if err != nil {
+		return err
+	}
+	failures = append(failures, serviceAccountFailures...)
+
+	if len(failures) > 0 {
+		return &PreflightError{Failures: failures}
+	}
+
+	return nil
+}
+
+// checkMachineType returns the machine type of the instance when it is available in the zone. Custom machine
+// types are not listed by the Compute API, so only their machine series is checked.
+func checkMachineType(instance *compute.Instance, capabilities *zoneCapabilities, zone string) (*compute.MachineType, []string) {
+	name := path.Base(instance.MachineType)
+	if series, ok := customMachineTypeSeries(name); ok {
+		if !capabilities.hasMachineSeries(series) {
+			return nil, []string{fmt.Sprintf("custom machine type %q: machine series %s is not available in zone %s", name, series, zone)}
+		}
+		return nil, nil
+	}
+
+	machineType, ok := capabilities.machineTypes[name]
+	if !ok {
+		return nil, []string{fmt.Sprintf("machine type %q is not available in zone %s", name, zone)}
+	}
+	if isDeprecated(machineType.Deprecated) {
+		return nil, []string{fmt.Sprintf("machine type %q is %s in zone %s", name, strings.ToLower(machineType.Deprecated.State), zone)}
+	}
+
+	return machineType, nil
+}
+
+// customMachineTypeSeries returns the machine series of a custom machine type, e.g. n2 for n2-custom-4-8192, or n1
+// for custom-4-16384.
+func customMachineTypeSeries(name string) (string, bool) {
+	if strings.HasPrefix(name, "custom-") {
+		return "n1", true
+	}
+	series, _, found := strings.Cut(name, "-custom-")
+	return series, found
+}
+
+func checkDiskTypes(instance *compute.Instance, capabilities *zoneCapabilities, zone string) []string {
+	var failures []string
+	for _, disk := range instance.Disks {
+		if disk.InitializeParams == nil || disk.InitializeParams.DiskType == "" {
+			continue
+		}
+
+		name := path.Base(disk.InitializeParams.DiskType)
+		if !capabilities.diskTypes.Has(name) {
+			failures = append(failures, fmt.Sprintf("disk type %q is not available in zone %s", name, zone))
+		}
+	}
+
+	return failures
+}
+
+// checkDiskNames checks that the disks named after the machine get a name the Compute API accepts, as the length
+// of the machine name is only known once the machine is created.
+func checkDiskNames(instance *compute.Instance) []string {
+	var failures []string
+	for _, disk := range instance.Disks {
+		if disk.InitializeParams == nil {
+			continue
+		}
+
+		if name := disk.InitializeParams.DiskName; len(name) > validation.DNS1035LabelMaxLength {
+			failures = append(failures, fmt.Sprintf("disk name %q is longer than %d characters", name, validation.DNS1035LabelMaxLength))
+		}
+	}
+
+	return failures
+}
+
+// checkImage checks that the boot image of the instance exists, is not obsolete and matches the architecture of the
+// machine type, if known.
+func (s *Service) checkImage(ctx context.Context, instance *compute.Instance, machineType *compute.MachineType) ([]string, error) {
+	for _, disk := range instance.Disks {
+		if !disk.Boot || disk.InitializeParams == nil || disk.InitializeParams.SourceImage == "" {
+			continue
+		}
+
+		sourceImage := disk.InitializeParams.SourceImage
+		match := sourceImageRegexp.FindStringSubmatch(sourceImage)
+		if match == nil {
+			log.FromContext(ctx).V(2).Info("Skipping pre-flight check of unrecognized image reference", "image", sourceImage)
+			return nil, nil
+		}
+
+		project := match[1]
+		if project == "" {
+			project = s.scope.Project()
+		}
+		get := s.images.Get
+		if match[2] == "family" {
+			get = s.images.GetFromFamily
+		}
+
+		image, err := get(ctx, meta.GlobalKey(match[3]), k8scloud.ForceProjectID(project))
+		switch {
+		case gcperrors.IsNotFound(err):
+			return []string{fmt.Sprintf("image %q not found", sourceImage)}, nil
+		case gcperrors.IsForbidden(err):
+			log.FromContext(ctx).V(2).Info("Skipping pre-flight check of inaccessible image", "image", sourceImage)
+			return nil, nil
+		case err != nil:
+			return nil, errors.Wrapf(err, "failed to get image %s", sourceImage)
+		}
+
+		if isDeprecated(image.Deprecated) {
+			return []string{fmt.Sprintf("image %q is %s", sourceImage, strings.ToLower(image.Deprecated.State))}, nil
+		}
+		if machineType != nil && machineType.Architecture != "" && image.Architecture != "" && machineType.Architecture != image.Architecture {
+			return []string{fmt.Sprintf("image %q is built for %s, which machine type %q does not support", sourceImage, image.Architecture, machineType.Name)}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// checkSubnetworks checks that the subnetworks of the instance exist in the region of its zone.
+func (s *Service) checkSubnetworks(ctx context.Context, instance *compute.Instance, zone string) ([]string, error) {
+	var failures []string
+	for _, networkInterface := range instance.NetworkInterfaces {
+		match := subnetworkRegexp.FindStringSubmatch(networkInterface.Subnetwork)
+		if match == nil {
+			continue
+		}
+
+		region, name := match[2], match[3]
+		if !strings.HasPrefix(zone, region+"-") {
+			failures = append(failures, fmt.Sprintf("subnetwork %q is not in the region of zone %s", networkInterface.Subnetwork, zone))
+			continue
+		}
+
+		_, err := s.subnetworks.Get(ctx, meta.RegionalKey(name, region), k8scloud.ForceProjectID(match[1]))
+		switch {
+		case gcperrors.IsNotFound(err):
+			failures = append(failures, fmt.Sprintf("subnetwork %q not found", networkInterface.Subnetwork))
+		case gcperrors.IsForbidden(err):
+			log.FromContext(ctx).V(2).Info("Skipping pre-flight check of inaccessible subnetwork", "subnetwork", networkInterface.Subnetwork)
+		case err != nil:
+			return nil, errors.Wrapf(err, "failed to get subnetwork %s", networkInterface.Subnetwork)
+		}
+	}
+
+	return failures, nil
+}
+
+// checkServiceAccounts checks that the project has a default compute service account when the instance uses it,
+// and that other service accounts are email addresses.
+func (s *Service) checkServiceAccounts(ctx context.Context, instance *compute.Instance) ([]string, error) {
+	var failures []string
+	for _, serviceAccount := range instance.ServiceAccounts {
+		if serviceAccount.Email != "default" {
+			if local, domain, found := strings.Cut(serviceAccount.Email, "@"); !found || local == "" || domain == "" || strings.Contains(domain, "@") {
+				failures = append(failures, fmt.Sprintf("service account %q is not an email address", serviceAccount.Email))
+			}
+			continue
+		}
+
+		project, err := s.projects.Get(ctx, s.scope.Project())
+		switch {
+		case gcperrors.IsForbidden(err):
+			log.FromContext(ctx).V(2).Info("Skipping pre-flight check of the default service account", "project", s.scope.Project())
+		case err != nil:
+			return nil, errors.Wrapf(err, "failed to get project %s", s.scope.Project())
+		case project.DefaultServiceAccount == "":
+			failures = append(failures, fmt.Sprintf("project %s has no default compute service account", s.scope.Project()))
+		}
+	}
+
+	return failures, nil
+}
+
+func isDeprecated(status *compute.DeprecationStatus) bool {
+	return status != nil && (status.State == "OBSOLETE" || status.State == "DELETED")
+}
+
+// zoneCapabilities are the machine types and disk types available in a project and zone.
+type zoneCapabilities struct {
+	machineTypes map[string]*compute.MachineType
+	diskTypes    sets.Set[string]
+	expiresAt    time.Time
+}
+
+// hasMachineSeries returns whether a machine type of the given machine series is available.
+func (c *zoneCapabilities) hasMachineSeries(series string) bool {
+	for name, machineType := range c.machineTypes {
+		if strings.HasPrefix(name, series+"-") && !isDeprecated(machineType.Deprecated) {
+			return true
+		}
+	}
+	return false
+}
+
+// zoneCapabilitiesCache caches zone capabilities per project and zone, as they rarely change and listing them
+// takes several requests.
+type zoneCapabilitiesCache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	items map[string]*zoneCapabilities
+}
+
+var defaultZoneCapabilitiesCache = newZoneCapabilitiesCache(zoneCapabilitiesTTL)
+
+func newZoneCapabilitiesCache(ttl time.Duration) *zoneCapabilitiesCache {
+	return &zoneCapabilitiesCache{
+		ttl:   ttl,
+		items: map[string]*zoneCapabilities{},
+	}
+}
+
+func (c *zoneCapabilitiesCache) get(project, zone string) (*zoneCapabilities, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	capabilities, ok := c.items[project+"/"+zone]
+	if !ok || time.Now().After(capabilities.expiresAt) {
+		return nil, false
+	}
+
+	return capabilities, true
+}
+
+func (c *zoneCapabilitiesCache) set(project, zone string, capabilities *zoneCapabilities) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	capabilities.expiresAt = time.Now().Add(c.ttl)
+	c.items[project+"/"+zone] = capabilities
+}
+
+// zoneCapabilities returns the machine types and disk types available in the zone, from the cache if possible.
+func (s *Service) zoneCapabilities(ctx context.Context, project, zone string) (*zoneCapabilities, error) {
+	if capabilities, ok := s.zoneCache.get(project, zone); ok {
+		return capabilities, nil
+	}
+
+	log.FromContext(ctx).V(2).Info("Listing machine types and disk types", "project", project, "zone", zone)
+	machineTypes, err := s.machineTypes.List(ctx, project, zone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list machine types in zone %s", zone)
+	}
+	diskTypes, err := s.diskTypes.List(ctx, project, zone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list disk types in zone %s", zone)
+	}
+
+	capabilities := &zoneCapabilities{
+		machineTypes: make(map[string]*compute.MachineType, len(machineTypes)),
+		diskTypes:    sets.New[string](),
+	}
+	for _, machineType := range machineTypes {
+		capabilities.machineTypes[machineType.Name] = machineType
+	}
+	for _, diskType := range diskTypes {
+		if !isDeprecated(diskType.Deprecated) {
+			capabilities.diskTypes.Insert(diskType.Name)
+		}
+	}
+	s.zoneCache.set(project, zone, capabilities)
+
+	return capabilities, nil
+}
+
+// machineTypesClient lists machine types with the compute service, as the cloud does not provide them.
+type machineTypesClient struct {
+	service *compute.Service
+}
+
+func (c *machineTypesClient) List(ctx context.Context, project, zone string) ([]*compute.MachineType, error) {
+	var machineTypes []*compute.MachineType
+	err := c.service.MachineTypes.List(project, zone).Pages(ctx, func(page *compute.MachineTypeList) error {
+		machineTypes = append(machineTypes, page.Items...)
+		return nil
+	})
+
+	return machineTypes, err
+}
+
+// diskTypesClient lists disk types with the compute service, as the cloud does not provide them.
+type diskTypesClient struct {
+	service *compute.Service
+}
+
+func (c *diskTypesClient) List(ctx context.Context, project, zone string) ([]*compute.DiskType, error) {
+	var diskTypes []*compute.DiskType
+	err := c.service.DiskTypes.List(project, zone).Pages(ctx, func(page *compute.DiskTypeList) error {
+		diskTypes = append(diskTypes, page.Items...)
+		return nil
+	})
+
+	return diskTypes, err
+}
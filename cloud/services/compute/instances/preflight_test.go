@@ -0,0 +1,391 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instances
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeMachineTypes struct {
+	items []*compute.MachineType
+	calls int
+}
+
+func (f *fakeMachineTypes) List(_ context.Context, _, _ string) ([]*compute.MachineType, error) {
+	f.calls++
+	return f.items, nil
+}
+
+type fakeDiskTypes struct {
+	items []*compute.DiskType
+	calls int
+}
+
+func (f *fakeDiskTypes) List(_ context.Context, _, _ string) ([]*compute.DiskType, error) {
+	f.calls++
+	return f.items, nil
+}
+
+func newPreflightMachineScope(t *testing.T) *scope.MachineScope {
+	t.Helper()
+	fakec := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(fakeBootstrapSecret).
+		Build()
+
+	clusterScope, err := scope.NewClusterScope(context.TODO(), scope.ClusterScopeParams{
+		Client:     fakec,
+		Cluster:    fakeCluster,
+		GCPCluster: fakeGCPCluster,
+		GCPServices: scope.GCPServices{
+			Compute: &compute.Service{},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gcpMachine := getFakeGCPMachine()
+	gcpMachine.Spec.InstanceType = "n2-standard-2"
+	gcpMachine.Spec.Subnet = ptr.To("my-subnet")
+	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+		Client:        fakec,
+		Machine:       fakeMachine,
+		GCPMachine:    gcpMachine,
+		ClusterGetter: clusterScope,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return machineScope
+}
+
+func TestService_Preflight(t *testing.T) {
+	machineTypes := func() *fakeMachineTypes {
+		return &fakeMachineTypes{items: []*compute.MachineType{{Name: "n2-standard-2", Architecture: "X86_64"}}}
+	}
+	diskTypes := func() *fakeDiskTypes {
+		return &fakeDiskTypes{items: []*compute.DiskType{{Name: "pd-standard"}, {Name: "pd-ssd"}}}
+	}
+	images := func(image *compute.Image, err error) *cloud.MockImages {
+		return &cloud.MockImages{
+			GetFromFamilyHook: func(_ context.Context, _ *meta.Key, _ *cloud.MockImages, _ ...cloud.Option) (*compute.Image, error) {
+				return image, err
+			},
+		}
+	}
+	subnetworks := func() *cloud.MockSubnetworks {
+		return cloud.NewMockSubnetworks(&cloud.SingleProjectRouter{ID: "my-proj"}, map[meta.Key]*cloud.MockSubnetworksObj{
+			*meta.RegionalKey("my-subnet", "us-central1"): {Obj: &compute.Subnetwork{Name: "my-subnet"}},
+		})
+	}
+	projects := func(defaultServiceAccount string) *cloud.MockProjects {
+		return cloud.NewMockProjects(&cloud.SingleProjectRouter{ID: "my-proj"}, map[meta.Key]*cloud.MockProjectsObj{
+			*meta.GlobalKey("my-proj"): {Obj: &compute.Project{Name: "my-proj", DefaultServiceAccount: defaultServiceAccount}},
+		})
+	}
+	noInstances := func() *cloud.MockInstances {
+		return cloud.NewMockInstances(&cloud.SingleProjectRouter{ID: "my-proj"}, map[meta.Key]*cloud.MockInstancesObj{})
+	}
+
+	tests := []struct {
+		name         string
+		instances    *cloud.MockInstances
+		machineTypes *fakeMachineTypes
+		diskTypes    *fakeDiskTypes
+		images       *cloud.MockImages
+		subnetworks  *cloud.MockSubnetworks
+		projects     *cloud.MockProjects
+		wantFailures []string
+		wantErr      bool
+	}{
+		{
+			name:         "all checks pass",
+			instances:    noInstances(),
+			machineTypes: machineTypes(),
+			diskTypes:    diskTypes(),
+			images:       images(&compute.Image{Name: "capi", Architecture: "X86_64"}, nil),
+			subnetworks:  subnetworks(),
+			projects:     projects("123-compute@developer.gserviceaccount.com"),
+		},
+		{
+			name: "instance already exists (should skip the checks)",
+			instances: cloud.NewMockInstances(&cloud.SingleProjectRouter{ID: "my-proj"}, map[meta.Key]*cloud.MockInstancesObj{
+				*meta.ZonalKey("my-machine", "us-central1-c"): {Obj: &compute.Instance{Name: "my-machine"}},
+			}),
+			machineTypes: &fakeMachineTypes{},
+			diskTypes:    &fakeDiskTypes{},
+		},
+		{
+			name:         "all checks fail",
+			instances:    noInstances(),
+			machineTypes: &fakeMachineTypes{items: []*compute.MachineType{{Name: "e2-small"}}},
+			diskTypes:    &fakeDiskTypes{items: []*compute.DiskType{{Name: "pd-ssd"}}},
+			images:       images(nil, &googleapi.Error{Code: http.StatusNotFound}),
+			subnetworks:  cloud.NewMockSubnetworks(&cloud.SingleProjectRouter{ID: "my-proj"}, map[meta.Key]*cloud.MockSubnetworksObj{}),
+			projects:     projects(""),
+			wantFailures: []string{
+				`machine type "n2-standard-2" is not available in zone us-central1-c`,
+				`disk type "pd-standard" is not available in zone us-central1-c`,
+				`image "projects/my-proj/global/images/family/capi-ubuntu-1804-k8s-v1-19" not found`,
+				`subnetwork "projects/my-proj/regions/us-central1/subnetworks/my-subnet" not found`,
+				"project my-proj has no default compute service account",
+			},
+		},
+		{
+			name:         "obsolete machine type and deprecated image",
+			instances:    noInstances(),
+			machineTypes: &fakeMachineTypes{items: []*compute.MachineType{{Name: "n2-standard-2", Deprecated: &compute.DeprecationStatus{State: "OBSOLETE"}}}},
+			diskTypes:    diskTypes(),
+			images:       images(&compute.Image{Name: "capi", Deprecated: &compute.DeprecationStatus{State: "DELETED"}}, nil),
+			subnetworks:  subnetworks(),
+			projects:     projects("123-compute@developer.gserviceaccount.com"),
+			wantFailures: []string{
+				`machine type "n2-standard-2" is obsolete in zone us-central1-c`,
+				`image "projects/my-proj/global/images/family/capi-ubuntu-1804-k8s-v1-19" is deleted`,
+			},
+		},
+		{
+			name:         "image architecture does not match the machine type",
+			instances:    noInstances(),
+			machineTypes: machineTypes(),
+			diskTypes:    diskTypes(),
+			images:       images(&compute.Image{Name: "capi", Architecture: "ARM64"}, nil),
+			subnetworks:  subnetworks(),
+			projects:     projects("123-compute@developer.gserviceaccount.com"),
+			wantFailures: []string{
+				`image "projects/my-proj/global/images/family/capi-ubuntu-1804-k8s-v1-19" is built for ARM64, which machine type "n2-standard-2" does not support`,
+			},
+		},
+		{
+			name:         "inaccessible image (should skip the image check)",
+			instances:    noInstances(),
+			machineTypes: machineTypes(),
+			diskTypes:    diskTypes(),
+			images:       images(nil, &googleapi.Error{Code: http.StatusForbidden}),
+			subnetworks:  subnetworks(),
+			projects:     projects("123-compute@developer.gserviceaccount.com"),
+		},
+		{
+			name:         "error getting image (should return an error)",
+			instances:    noInstances(),
+			machineTypes: machineTypes(),
+			diskTypes:    diskTypes(),
+			images:       images(nil, &googleapi.Error{Code: http.StatusInternalServerError}),
+			subnetworks:  subnetworks(),
+			projects:     projects("123-compute@developer.gserviceaccount.com"),
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(newPreflightMachineScope(t))
+			s.instances = tt.instances
+			s.machineTypes = tt.machineTypes
+			s.diskTypes = tt.diskTypes
+			s.images = tt.images
+			s.subnetworks = tt.subnetworks
+			s.projects = tt.projects
+			s.zoneCache = newZoneCapabilitiesCache(zoneCapabilitiesTTL)
+
+			err := s.Preflight(context.TODO())
+			var preflightErr *PreflightError
+			switch {
+			case errors.As(err, &preflightErr):
+				if d := cmp.Diff(tt.wantFailures, preflightErr.Failures); d != "" {
+					t.Errorf("Service.Preflight() mismatch (-want +got):\n%s", d)
+				}
+			case (err != nil) != tt.wantErr:
+				t.Errorf("Service.Preflight() error = %v, wantErr %v", err, tt.wantErr)
+			case tt.wantFailures != nil:
+				t.Errorf("Service.Preflight() error = %v, want failures %v", err, tt.wantFailures)
+			}
+		})
+	}
+}
+
+func TestService_PreflightImageLookupArchitecture(t *testing.T) {
+	armImage := &compute.Image{
+		Name:         "capi-arm64",
+		SelfLink:     "projects/my-proj/global/images/capi-arm64",
+		Architecture: "ARM64",
+		Labels:       map[string]string{"os": "ubuntu-2204"},
+	}
+	images := []*compute.Image{
+		{
+			Name:         "capi-amd64",
+			SelfLink:     "projects/my-proj/global/images/capi-amd64",
+			Architecture: "X86_64",
+			Labels:       map[string]string{"os": "ubuntu-2204"},
+		},
+		armImage,
+	}
+
+	machineScope := newPreflightMachineScope(t)
+	// A machine series the provider doesn't know to be Arm, whose architecture is only reported by the Compute API.
+	machineScope.GCPMachine.Spec.InstanceType = "z9a-standard-4"
+	machineScope.GCPMachine.Spec.ImageLookup = &infrav1.ImageLookup{
+		Labels:                 map[string]string{"os": "ubuntu-2204"},
+		KubernetesVersionLabel: ptr.To(""),
+	}
+	s := New(machineScope)
+	s.instances = cloud.NewMockInstances(&cloud.SingleProjectRouter{ID: "my-proj"}, map[meta.Key]*cloud.MockInstancesObj{})
+	s.machineTypes = &fakeMachineTypes{items: []*compute.MachineType{{Name: "z9a-standard-4", Architecture: "ARM64"}}}
+	s.diskTypes = &fakeDiskTypes{items: []*compute.DiskType{{Name: "pd-standard"}}}
+	s.images = &cloud.MockImages{
+		ListHook: func(_ context.Context, _ *filter.F, _ *cloud.MockImages, _ ...cloud.Option) (bool, []*compute.Image, error) {
+			return true, images, nil
+		},
+		GetHook: func(_ context.Context, _ *meta.Key, _ *cloud.MockImages, _ ...cloud.Option) (bool, *compute.Image, error) {
+			return true, armImage, nil
+		},
+	}
+	s.subnetworks = cloud.NewMockSubnetworks(&cloud.SingleProjectRouter{ID: "my-proj"}, map[meta.Key]*cloud.MockSubnetworksObj{
+		*meta.RegionalKey("my-subnet", "us-central1"): {Obj: &compute.Subnetwork{Name: "my-subnet"}},
+	})
+	s.projects = cloud.NewMockProjects(&cloud.SingleProjectRouter{ID: "my-proj"}, map[meta.Key]*cloud.MockProjectsObj{
+		*meta.GlobalKey("my-proj"): {Obj: &compute.Project{Name: "my-proj", DefaultServiceAccount: "123-compute@developer.gserviceaccount.com"}},
+	})
+	s.zoneCache = newZoneCapabilitiesCache(zoneCapabilitiesTTL)
+
+	if err := s.Preflight(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if got := ptr.Deref(machineScope.GCPMachine.Status.Image, ""); got != armImage.SelfLink {
+		t.Errorf("Service.Preflight() selected image %q, want %q", got, armImage.SelfLink)
+	}
+}
+
+func TestCheckMachineType(t *testing.T) {
+	capabilities := &zoneCapabilities{machineTypes: map[string]*compute.MachineType{
+		"n1-standard-2": {Name: "n1-standard-2"},
+		"n2-standard-2": {Name: "n2-standard-2", Architecture: "X86_64"},
+	}}
+
+	tests := []struct {
+		name            string
+		machineType     string
+		wantMachineType string
+		wantFailures    []string
+	}{
+		{
+			name:            "listed machine type",
+			machineType:     "zones/us-central1-c/machineTypes/n2-standard-2",
+			wantMachineType: "n2-standard-2",
+		},
+		{
+			name:        "N1 custom machine type",
+			machineType: "zones/us-central1-c/machineTypes/custom-4-16384",
+		},
+		{
+			name:        "custom machine type of an available series",
+			machineType: "zones/us-central1-c/machineTypes/n2-custom-4-8192",
+		},
+		{
+			name:         "custom machine type of an unavailable series",
+			machineType:  "zones/us-central1-c/machineTypes/n2d-custom-4-8192",
+			wantFailures: []string{`custom machine type "n2d-custom-4-8192": machine series n2d is not available in zone us-central1-c`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machineType, failures := checkMachineType(&compute.Instance{MachineType: tt.machineType}, capabilities, "us-central1-c")
+			if d := cmp.Diff(tt.wantFailures, failures); d != "" {
+				t.Errorf("checkMachineType() failures mismatch (-want +got):\n%s", d)
+			}
+			got := ""
+			if machineType != nil {
+				got = machineType.Name
+			}
+			if got != tt.wantMachineType {
+				t.Errorf("checkMachineType() machine type = %q, want %q", got, tt.wantMachineType)
+			}
+		})
+	}
+}
+
+func TestCheckDiskNames(t *testing.T) {
+	instance := &compute.Instance{
+		Disks: []*compute.AttachedDisk{
+			{Boot: true, InitializeParams: &compute.AttachedDiskInitializeParams{}},
+			{InitializeParams: &compute.AttachedDiskInitializeParams{DiskName: "my-machine-data"}},
+			{InitializeParams: &compute.AttachedDiskInitializeParams{DiskName: "my-cluster-control-plane-with-a-rather-long-name-abcde-etcd-data"}},
+			{Source: "zones/us-central1-c/disks/my-disk"},
+		},
+	}
+
+	want := []string{`disk name "my-cluster-control-plane-with-a-rather-long-name-abcde-etcd-data" is longer than 63 characters`}
+	if d := cmp.Diff(want, checkDiskNames(instance)); d != "" {
+		t.Errorf("checkDiskNames() mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestService_zoneCapabilities(t *testing.T) {
+	machineTypes := &fakeMachineTypes{items: []*compute.MachineType{{Name: "n2-standard-2"}}}
+	diskTypes := &fakeDiskTypes{items: []*compute.DiskType{{Name: "pd-standard"}, {Name: "pd-old", Deprecated: &compute.DeprecationStatus{State: "OBSOLETE"}}}}
+	cache := newZoneCapabilitiesCache(zoneCapabilitiesTTL)
+
+	s := New(newPreflightMachineScope(t))
+	s.machineTypes = machineTypes
+	s.diskTypes = diskTypes
+	s.zoneCache = cache
+	for range 2 {
+		capabilities, err := s.zoneCapabilities(context.TODO(), "my-proj", "us-central1-c")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := capabilities.machineTypes["n2-standard-2"]; !ok {
+			t.Errorf("zoneCapabilities() is missing machine type n2-standard-2")
+		}
+		if d := cmp.Diff([]string{"pd-standard"}, capabilities.diskTypes.UnsortedList()); d != "" {
+			t.Errorf("zoneCapabilities() disk types mismatch (-want +got):\n%s", d)
+		}
+	}
+	if machineTypes.calls != 1 || diskTypes.calls != 1 {
+		t.Errorf("zoneCapabilities() listed machine types %d times and disk types %d times, want once each", machineTypes.calls, diskTypes.calls)
+	}
+
+	if _, err := s.zoneCapabilities(context.TODO(), "my-proj", "us-central1-a"); err != nil {
+		t.Fatal(err)
+	}
+	if machineTypes.calls != 2 {
+		t.Errorf("zoneCapabilities() listed machine types %d times, want a new list for another zone", machineTypes.calls)
+	}
+
+	cache.ttl = 0
+	cache.set("my-proj", "us-central1-c", &zoneCapabilities{})
+	if _, ok := cache.get("my-proj", "us-central1-c"); ok {
+		t.Errorf("zoneCapabilitiesCache.get() returned expired capabilities")
+	}
+}
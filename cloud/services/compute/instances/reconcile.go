@@ -87,6 +87,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	s.scope.SetProviderID()
 	s.scope.SetAddresses(addresses)
 	s.scope.SetInstanceStatus(infrav1.InstanceStatus(instance.Status))
+	s.scope.SetDiskEncryptionKeys(instance.Disks, s.primaryKeyVersions(ctx))
 
 	if s.scope.IsControlPlane() {
 		if err := s.registerControlPlaneInstance(ctx, instance); err != nil {
@@ -134,13 +135,8 @@ func (s *Service) createOrGetInstance(ctx context.Context) (*compute.Instance, e
 		return nil, errors.Wrap(err, "failed to retrieve bootstrap data")
 	}
 
-	instanceSpec := s.scope.InstanceSpec(log)
-	instanceName := instanceSpec.Name
+	instanceName := s.scope.Name()
 	instanceKey := meta.ZonalKey(instanceName, s.scope.Zone())
-	instanceSpec.Metadata.Items = append(instanceSpec.Metadata.Items, &compute.MetadataItems{
-		Key:   "user-data",
-		Value: ptr.To[string](bootstrapData),
-	})
 
 	log.V(2).Info("Looking for instance", "name", instanceName, "zone", s.scope.Zone())
 	instance, err := s.instances.Get(ctx, instanceKey)
@@ -150,6 +146,21 @@ func (s *Service) createOrGetInstance(ctx context.Context) (*compute.Instance, e
 			return nil, err
 		}
 
+		if err := s.resolveImage(ctx, s.scope.Architecture()); err != nil {
+			log.Error(err, "Error looking up image for instance", "name", instanceName)
+			return nil, err
+		}
+
+		instanceSpec := s.scope.InstanceSpec(log)
+		instanceSpec.Metadata.Items = append(instanceSpec.Metadata.Items, &compute.MetadataItems{
+			Key:   "user-data",
+			Value: ptr.To[string](bootstrapData),
+		})
+
+		if err := s.attachExistingDisks(ctx, instanceSpec); err != nil {
+			return nil, err
+		}
+
 		log.V(2).Info("Creating an instance", "name", instanceName, "zone", s.scope.Zone())
 		if err := s.instances.Insert(ctx, instanceKey, instanceSpec); err != nil {
 			log.Error(err, "Error creating an instance", "name", instanceName, "zone", s.scope.Zone())
@@ -165,6 +176,36 @@ func (s *Service) createOrGetInstance(ctx context.Context) (*compute.Instance, e
 	return instance, nil
 }
 
+// attachExistingDisks replaces the initialization parameters of named additional disks that already exist,
+// e.g. disks retained from a previous machine, with a reference to the existing disk. The encryption key of the
+// disk is kept, as GCP needs it to attach disks encrypted with a customer-supplied key.
+func (s *Service) attachExistingDisks(ctx context.Context, instance *compute.Instance) error {
+	log := log.FromContext(ctx)
+	for _, disk := range instance.Disks {
+		if disk.Boot || disk.InitializeParams == nil || disk.InitializeParams.DiskName == "" {
+			continue
+		}
+
+		diskName := disk.InitializeParams.DiskName
+		log.V(2).Info("Looking for existing disk", "name", diskName, "zone", s.scope.Zone())
+		existing, err := s.disks.Get(ctx, meta.ZonalKey(diskName, s.scope.Zone()))
+		if err != nil {
+			if !gcperrors.IsNotFound(err) {
+				log.Error(err, "Error looking for existing disk", "name", diskName, "zone", s.scope.Zone())
+				return err
+			}
+
+			continue
+		}
+
+		log.V(2).Info("Attaching existing disk", "name", diskName, "zone", s.scope.Zone())
+		disk.Source = existing.SelfLink
+		disk.InitializeParams = nil
+	}
+
+	return nil
+}
+
 func (s *Service) registerControlPlaneInstance(ctx context.Context, instance *compute.Instance) error {
 	log := log.FromContext(ctx)
 	instancegroupName := s.scope.ControlPlaneGroupName()
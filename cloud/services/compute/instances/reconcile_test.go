@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
+	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/compute/v1"
@@ -189,6 +190,8 @@ func TestService_createOrGetInstance(t *testing.T) {
 		name         string
 		scope        func() Scope
 		mockInstance *cloud.MockInstances
+		mockImages   *cloud.MockImages
+		mockDisks    *cloud.MockDisks
 		want         *compute.Instance
 		wantErr      bool
 	}{
@@ -350,6 +353,324 @@ func TestService_createOrGetInstance(t *testing.T) {
 				Zone: "us-central1-c",
 			},
 		},
+		{
+			name: "instance does not exist (should create instance) with image lookup",
+			scope: func() Scope {
+				machineScope.GCPMachine = getFakeGCPMachine()
+				machineScope.GCPMachine.Spec.ImageLookup = &infrav1.ImageLookup{
+					Project: ptr.To[string]("images-proj"),
+					Labels: map[string]string{
+						"os": "ubuntu-2204",
+					},
+				}
+				return machineScope
+			},
+			mockInstance: &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+				Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+			},
+			mockImages: &cloud.MockImages{
+				ListHook: func(_ context.Context, _ *filter.F, _ *cloud.MockImages, _ ...cloud.Option) (bool, []*compute.Image, error) {
+					return true, []*compute.Image{
+						{
+							SelfLink:          "projects/images-proj/global/images/old",
+							CreationTimestamp: "2024-01-01T00:00:00Z",
+							Labels:            map[string]string{"os": "ubuntu-2204", "kubernetes-version": "v1-19-11"},
+						},
+						{
+							SelfLink:          "projects/images-proj/global/images/deprecated",
+							CreationTimestamp: "2024-03-01T00:00:00Z",
+							Labels:            map[string]string{"os": "ubuntu-2204", "kubernetes-version": "v1-19-11"},
+							Deprecated:        &compute.DeprecationStatus{State: "DEPRECATED"},
+						},
+						{
+							SelfLink:          "projects/images-proj/global/images/other-version",
+							CreationTimestamp: "2024-04-01T00:00:00Z",
+							Labels:            map[string]string{"os": "ubuntu-2204", "kubernetes-version": "v1-20-1"},
+						},
+						{
+							SelfLink:          "projects/images-proj/global/images/new",
+							CreationTimestamp: "2024-02-01T00:00:00Z",
+							Labels:            map[string]string{"os": "ubuntu-2204", "kubernetes-version": "v1-19-11"},
+						},
+					}, nil
+				},
+			},
+			want: &compute.Instance{
+				Name:         "my-machine",
+				CanIpForward: true,
+				Disks: []*compute.AttachedDisk{
+					{
+						AutoDelete: true,
+						Boot:       true,
+						InitializeParams: &compute.AttachedDiskInitializeParams{
+							DiskType:            "zones/us-central1-c/diskTypes/pd-standard",
+							SourceImage:         "projects/images-proj/global/images/new",
+							ResourceManagerTags: map[string]string{},
+							Labels: map[string]string{
+								"foo": "bar",
+							},
+						},
+					},
+				},
+				Labels: map[string]string{
+					"capg-role":               "node",
+					"capg-cluster-my-cluster": "owned",
+					"foo":                     "bar",
+				},
+				MachineType: "zones/us-central1-c/machineTypes",
+				Metadata: &compute.Metadata{
+					Items: []*compute.MetadataItems{
+						{
+							Key:   "user-data",
+							Value: ptr.To[string]("Zm9vCg=="),
+						},
+					},
+				},
+				NetworkInterfaces: []*compute.NetworkInterface{
+					{
+						Network: "projects/my-proj/global/networks/default",
+					},
+				},
+				Params: &compute.InstanceParams{
+					ResourceManagerTags: map[string]string{},
+				},
+				SelfLink:   "https://www.googleapis.com/compute/v1/projects/proj-id/zones/us-central1-c/instances/my-machine",
+				Scheduling: &compute.Scheduling{},
+				ServiceAccounts: []*compute.ServiceAccount{
+					{
+						Email:  "default",
+						Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"},
+					},
+				},
+				Tags: &compute.Tags{
+					Items: []string{
+						"my-cluster-node",
+						"my-cluster",
+					},
+				},
+				Zone: "us-central1-c",
+			},
+		},
+		{
+			name: "instance does not exist and no image matches the image lookup (should return an error)",
+			scope: func() Scope {
+				machineScope.GCPMachine = getFakeGCPMachine()
+				machineScope.GCPMachine.Spec.ImageLookup = &infrav1.ImageLookup{}
+				return machineScope
+			},
+			mockInstance: &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+				Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+			},
+			mockImages: &cloud.MockImages{
+				ListHook: func(_ context.Context, _ *filter.F, _ *cloud.MockImages, _ ...cloud.Option) (bool, []*compute.Image, error) {
+					return true, []*compute.Image{}, nil
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance does not exist and the image lookup has no labels (should return an error)",
+			scope: func() Scope {
+				machineScope.GCPMachine = getFakeGCPMachine()
+				machineScope.GCPMachine.Spec.ImageLookup = &infrav1.ImageLookup{
+					KubernetesVersionLabel: ptr.To[string](""),
+				}
+				return machineScope
+			},
+			mockInstance: &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+				Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+			},
+			mockImages: &cloud.MockImages{
+				ListHook: func(_ context.Context, _ *filter.F, _ *cloud.MockImages, _ ...cloud.Option) (bool, []*compute.Image, error) {
+					return true, []*compute.Image{
+						{
+							SelfLink:          "projects/my-proj/global/images/any",
+							CreationTimestamp: "2024-01-01T00:00:00Z",
+						},
+					}, nil
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance does not exist (should create instance) with arm64 machine type",
+			scope: func() Scope {
+				machineScope.GCPMachine = getFakeGCPMachine()
+				machineScope.GCPMachine.Spec.InstanceType = "t2a-standard-4"
+				return machineScope
+			},
+			mockInstance: &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+				Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+			},
+			want: &compute.Instance{
+				Name:         "my-machine",
+				CanIpForward: true,
+				Disks: []*compute.AttachedDisk{
+					{
+						AutoDelete: true,
+						Boot:       true,
+						InitializeParams: &compute.AttachedDiskInitializeParams{
+							DiskType:            "zones/us-central1-c/diskTypes/pd-standard",
+							SourceImage:         "projects/my-proj/global/images/family/capi-ubuntu-1804-k8s-v1-19-arm64",
+							ResourceManagerTags: map[string]string{},
+							Labels: map[string]string{
+								"foo": "bar",
+							},
+						},
+					},
+				},
+				Labels: map[string]string{
+					"capg-role":               "node",
+					"capg-cluster-my-cluster": "owned",
+					"foo":                     "bar",
+				},
+				MachineType: "zones/us-central1-c/machineTypes/t2a-standard-4",
+				Metadata: &compute.Metadata{
+					Items: []*compute.MetadataItems{
+						{
+							Key:   "user-data",
+							Value: ptr.To[string]("Zm9vCg=="),
+						},
+					},
+				},
+				NetworkInterfaces: []*compute.NetworkInterface{
+					{
+						Network: "projects/my-proj/global/networks/default",
+					},
+				},
+				Params: &compute.InstanceParams{
+					ResourceManagerTags: map[string]string{},
+				},
+				SelfLink:   "https://www.googleapis.com/compute/v1/projects/proj-id/zones/us-central1-c/instances/my-machine",
+				Scheduling: &compute.Scheduling{},
+				ServiceAccounts: []*compute.ServiceAccount{
+					{
+						Email:  "default",
+						Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"},
+					},
+				},
+				Tags: &compute.Tags{
+					Items: []string{
+						"my-cluster-node",
+						"my-cluster",
+					},
+				},
+				Zone: "us-central1-c",
+			},
+		},
+		{
+			name: "instance does not exist (should create instance) and attach retained additional disk",
+			scope: func() Scope {
+				machineScope.GCPMachine = getFakeGCPMachine()
+				machineScope.GCPMachine.Spec.AdditionalDisks = []infrav1.AttachedDiskSpec{
+					{
+						DeviceType:   ptr.To(infrav1.PdBalancedDiskType),
+						Name:         ptr.To[string]("my-machine-data"),
+						RetainPolicy: ptr.To(infrav1.DiskRetainPolicyRetain),
+						EncryptionKey: &infrav1.CustomerEncryptionKey{
+							KeyType: infrav1.CustomerManagedKey,
+							ManagedKey: &infrav1.ManagedKey{
+								KMSKeyName: "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key",
+							},
+						},
+					},
+					{
+						DeviceType:   ptr.To(infrav1.PdBalancedDiskType),
+						Name:         ptr.To[string]("my-machine-logs"),
+						RetainPolicy: ptr.To(infrav1.DiskRetainPolicyRetain),
+					},
+				}
+				return machineScope
+			},
+			mockInstance: &cloud.MockInstances{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+				Objects:       map[meta.Key]*cloud.MockInstancesObj{},
+			},
+			mockDisks: &cloud.MockDisks{
+				ProjectRouter: &cloud.SingleProjectRouter{ID: "proj-id"},
+				Objects: map[meta.Key]*cloud.MockDisksObj{
+					{Name: "my-machine-data", Zone: "us-central1-c"}: {Obj: &compute.Disk{
+						Name:     "my-machine-data",
+						SelfLink: "https://www.googleapis.com/compute/v1/projects/proj-id/zones/us-central1-c/disks/my-machine-data",
+					}},
+				},
+			},
+			want: &compute.Instance{
+				Name:         "my-machine",
+				CanIpForward: true,
+				Disks: []*compute.AttachedDisk{
+					{
+						AutoDelete: true,
+						Boot:       true,
+						InitializeParams: &compute.AttachedDiskInitializeParams{
+							DiskType:            "zones/us-central1-c/diskTypes/pd-standard",
+							SourceImage:         "projects/my-proj/global/images/family/capi-ubuntu-1804-k8s-v1-19",
+							ResourceManagerTags: map[string]string{},
+							Labels: map[string]string{
+								"foo": "bar",
+							},
+						},
+					},
+					{
+						AutoDelete: false,
+						Source:     "https://www.googleapis.com/compute/v1/projects/proj-id/zones/us-central1-c/disks/my-machine-data",
+						DiskEncryptionKey: &compute.CustomerEncryptionKey{
+							KmsKeyName: "projects/my-project/locations/us-central1/keyRings/us-central1/cryptoKeys/some-key",
+						},
+					},
+					{
+						AutoDelete: false,
+						InitializeParams: &compute.AttachedDiskInitializeParams{
+							DiskName:            "my-machine-logs",
+							DiskSizeGb:          30,
+							DiskType:            "zones/us-central1-c/diskTypes/pd-balanced",
+							ResourceManagerTags: map[string]string{},
+						},
+					},
+				},
+				Labels: map[string]string{
+					"capg-role":               "node",
+					"capg-cluster-my-cluster": "owned",
+					"foo":                     "bar",
+				},
+				MachineType: "zones/us-central1-c/machineTypes",
+				Metadata: &compute.Metadata{
+					Items: []*compute.MetadataItems{
+						{
+							Key:   "user-data",
+							Value: ptr.To[string]("Zm9vCg=="),
+						},
+					},
+				},
+				NetworkInterfaces: []*compute.NetworkInterface{
+					{
+						Network: "projects/my-proj/global/networks/default",
+					},
+				},
+				Params: &compute.InstanceParams{
+					ResourceManagerTags: map[string]string{},
+				},
+				SelfLink:   "https://www.googleapis.com/compute/v1/projects/proj-id/zones/us-central1-c/instances/my-machine",
+				Scheduling: &compute.Scheduling{},
+				ServiceAccounts: []*compute.ServiceAccount{
+					{
+						Email:  "default",
+						Scopes: []string{"https://www.googleapis.com/auth/cloud-platform"},
+					},
+				},
+				Tags: &compute.Tags{
+					Items: []string{
+						"my-cluster-node",
+						"my-cluster",
+					},
+				},
+				Zone: "us-central1-c",
+			},
+		},
 		{
 			name: "instance does not exist (should create instance) and SecureBoot enabled",
 			scope: func() Scope {
@@ -864,7 +1185,7 @@ func TestService_createOrGetInstance(t *testing.T) {
 				diskEncryption := infrav1.CustomerEncryptionKey{
 					KeyType: infrav1.CustomerSuppliedKey,
 					SuppliedKey: &infrav1.SuppliedKey{
-						RawKey: []byte("SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0="),
+						RawKey: []byte("Hello from Google Cloud Platform"),
 					},
 				}
 				machineScope.GCPMachine.Spec.RootDiskEncryptionKey = &diskEncryption
@@ -940,7 +1261,7 @@ func TestService_createOrGetInstance(t *testing.T) {
 				diskEncryption := infrav1.CustomerEncryptionKey{
 					KeyType: infrav1.CustomerSuppliedKey,
 					SuppliedKey: &infrav1.SuppliedKey{
-						RSAEncryptedKey: []byte("ieCx/NcW06PcT7Ep1X6LUTc/hLvUDYyzSZPPVCVPTVEohpeHASqC8uw5TzyO9U+Fka9JFHiz0mBibXUInrC/jEk014kCK/NPjYgEMOyssZ4ZINPKxlUh2zn1bV+MCaTICrdmuSBTWlUUiFoDiD6PYznLwh8ZNdaheCeZ8ewEXgFQ8V+sDroLaN3Xs3MDTXQEMMoNUXMCZEIpg9Vtp9x2oe=="),
+						RSAEncryptedKey: []byte("RSA wrapped customer-supplied key"),
 					},
 				}
 				machineScope.GCPMachine.Spec.RootDiskEncryptionKey = &diskEncryption
@@ -966,7 +1287,7 @@ func TestService_createOrGetInstance(t *testing.T) {
 							},
 						},
 						DiskEncryptionKey: &compute.CustomerEncryptionKey{
-							RsaEncryptedKey: "ieCx/NcW06PcT7Ep1X6LUTc/hLvUDYyzSZPPVCVPTVEohpeHASqC8uw5TzyO9U+Fka9JFHiz0mBibXUInrC/jEk014kCK/NPjYgEMOyssZ4ZINPKxlUh2zn1bV+MCaTICrdmuSBTWlUUiFoDiD6PYznLwh8ZNdaheCeZ8ewEXgFQ8V+sDroLaN3Xs3MDTXQEMMoNUXMCZEIpg9Vtp9x2oe==",
+							RsaEncryptedKey: "UlNBIHdyYXBwZWQgY3VzdG9tZXItc3VwcGxpZWQga2V5",
 						},
 					},
 				},
@@ -1015,6 +1336,12 @@ func TestService_createOrGetInstance(t *testing.T) {
 			ctx := context.TODO()
 			s := New(tt.scope())
 			s.instances = tt.mockInstance
+			if tt.mockImages != nil {
+				s.images = tt.mockImages
+			}
+			if tt.mockDisks != nil {
+				s.disks = tt.mockDisks
+			}
 			got, err := s.createOrGetInstance(ctx)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.createOrGetInstance() error = %v, wantErr %v", err, tt.wantErr)
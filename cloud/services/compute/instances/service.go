@@ -24,8 +24,10 @@ import (
 	k8scloud "github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/filter"
 	"github.com/GoogleCloudPlatform/k8s-cloud-provider/pkg/cloud/meta"
+	"google.golang.org/api/cloudkms/v1"
 	"google.golang.org/api/compute/v1"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud"
 )
 
@@ -35,6 +37,36 @@ type instancesInterface interface {
 	Delete(ctx context.Context, key *meta.Key, options ...k8scloud.Option) error
 }
 
+type disksInterface interface {
+	Get(ctx context.Context, key *meta.Key, options ...k8scloud.Option) (*compute.Disk, error)
+}
+
+type imagesInterface interface {
+	Get(ctx context.Context, key *meta.Key, options ...k8scloud.Option) (*compute.Image, error)
+	GetFromFamily(ctx context.Context, key *meta.Key, options ...k8scloud.Option) (*compute.Image, error)
+	List(ctx context.Context, fl *filter.F, options ...k8scloud.Option) ([]*compute.Image, error)
+}
+
+type subnetworksInterface interface {
+	Get(ctx context.Context, key *meta.Key, options ...k8scloud.Option) (*compute.Subnetwork, error)
+}
+
+type projectsInterface interface {
+	Get(ctx context.Context, projectID string) (*compute.Project, error)
+}
+
+type machineTypesInterface interface {
+	List(ctx context.Context, project, zone string) ([]*compute.MachineType, error)
+}
+
+type diskTypesInterface interface {
+	List(ctx context.Context, project, zone string) ([]*compute.DiskType, error)
+}
+
+type cryptoKeysInterface interface {
+	Get(ctx context.Context, name string) (*cloudkms.CryptoKey, error)
+}
+
 type instancegroupsInterface interface {
 	AddInstances(ctx context.Context, key *meta.Key, req *compute.InstanceGroupsAddInstancesRequest, options ...k8scloud.Option) error
 	ListInstances(ctx context.Context, key *meta.Key, req *compute.InstanceGroupsListInstancesRequest, fl *filter.F, options ...k8scloud.Option) ([]*compute.InstanceWithNamedPorts, error)
@@ -44,6 +76,11 @@ type instancegroupsInterface interface {
 // Scope is an interfaces that hold used methods.
 type Scope interface {
 	cloud.Machine
+	Architecture() infrav1.Architecture
+	KubernetesVersion() string
+	ImageLookup() *infrav1.ImageLookup
+	SetImage(v string)
+	SetDiskEncryptionKeys(disks []*compute.AttachedDisk, primaryKeyVersions map[string]string)
 	InstanceSpec(log logr.Logger) *compute.Instance
 	InstanceImageSpec() *compute.AttachedDisk
 	InstanceAdditionalDiskSpec() []*compute.AttachedDisk
@@ -54,6 +91,20 @@ type Service struct {
 	scope          Scope
 	instances      instancesInterface
 	instancegroups instancegroupsInterface
+	disks          disksInterface
+	images         imagesInterface
+	subnetworks    subnetworksInterface
+	projects       projectsInterface
+	machineTypes   machineTypesInterface
+	diskTypes      diskTypesInterface
+	cryptoKeys     cryptoKeysInterface
+
+	// imageCache holds the images found by lookups during a single reconcile, keyed by project and filter.
+	imageCache map[string]*compute.Image
+	// zoneCache holds the machine types and disk types available in a zone across reconciles.
+	zoneCache *zoneCapabilitiesCache
+	// keyVersionCache holds the primary versions of Cloud KMS keys across reconciles.
+	keyVersionCache *primaryKeyVersionCache
 }
 
 var _ cloud.Reconciler = &Service{}
@@ -61,8 +112,18 @@ var _ cloud.Reconciler = &Service{}
 // New returns Service from given scope.
 func New(scope Scope) *Service {
 	return &Service{
-		scope:          scope,
-		instances:      scope.Cloud().Instances(),
-		instancegroups: scope.Cloud().InstanceGroups(),
+		scope:           scope,
+		instances:       scope.Cloud().Instances(),
+		instancegroups:  scope.Cloud().InstanceGroups(),
+		disks:           scope.Cloud().Disks(),
+		images:          scope.Cloud().Images(),
+		subnetworks:     scope.NetworkCloud().Subnetworks(),
+		projects:        scope.Cloud().Projects(),
+		machineTypes:    &machineTypesClient{service: scope.ComputeService()},
+		diskTypes:       &diskTypesClient{service: scope.ComputeService()},
+		cryptoKeys:      &cryptoKeysClient{scope: scope},
+		imageCache:      map[string]*compute.Image{},
+		zoneCache:       defaultZoneCapabilitiesCache,
+		keyVersionCache: defaultPrimaryKeyVersionCache,
 	}
 }
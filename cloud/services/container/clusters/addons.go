@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+// convertToSdkAddonsConfig converts the AddonsConfig defined in CRs to the SDK version.
+// Add-ons that are not specified are left unset so GKE keeps their current state.
+func convertToSdkAddonsConfig(config *infrav1exp.AddonsConfig) *containerpb.AddonsConfig {
+	if config == nil {
+		return nil
+	}
+
+	sdkConfig := &containerpb.AddonsConfig{}
+	if config.HTTPLoadBalancing != nil {
+		sdkConfig.HttpLoadBalancing = &containerpb.HttpLoadBalancing{Disabled: !*config.HTTPLoadBalancing}
+	}
+	if config.HorizontalPodAutoscaling != nil {
+		sdkConfig.HorizontalPodAutoscaling = &containerpb.HorizontalPodAutoscaling{Disabled: !*config.HorizontalPodAutoscaling}
+	}
+	if config.NetworkPolicy != nil {
+		sdkConfig.NetworkPolicyConfig = &containerpb.NetworkPolicyConfig{Disabled: !*config.NetworkPolicy}
+	}
+	if config.GcePersistentDiskCsiDriver != nil {
+		sdkConfig.GcePersistentDiskCsiDriverConfig = &containerpb.GcePersistentDiskCsiDriverConfig{Enabled: *config.GcePersistentDiskCsiDriver}
+	}
+	if config.GcpFilestoreCsiDriver != nil {
+		sdkConfig.GcpFilestoreCsiDriverConfig = &containerpb.GcpFilestoreCsiDriverConfig{Enabled: *config.GcpFilestoreCsiDriver}
+	}
+	if config.GcsFuseCsiDriver != nil {
+		sdkConfig.GcsFuseCsiDriverConfig = &containerpb.GcsFuseCsiDriverConfig{Enabled: *config.GcsFuseCsiDriver}
+	}
+	if config.NodeLocalDNS != nil {
+		sdkConfig.DnsCacheConfig = &containerpb.DnsCacheConfig{Enabled: *config.NodeLocalDNS}
+	}
+	if config.ConfigConnector != nil {
+		sdkConfig.ConfigConnectorConfig = &containerpb.ConfigConnectorConfig{Enabled: *config.ConfigConnector}
+	}
+	if config.GkeBackupAgent != nil {
+		sdkConfig.GkeBackupAgentConfig = &containerpb.GkeBackupAgentConfig{Enabled: *config.GkeBackupAgent}
+	}
+
+	return sdkConfig
+}
+
+// compareAddonsConfig returns true if every add-on set in desired has the same state in existing.
+func compareAddonsConfig(desired, existing *containerpb.AddonsConfig) bool {
+	if desired == nil {
+		return true
+	}
+
+	if desired.HttpLoadBalancing != nil && desired.GetHttpLoadBalancing().GetDisabled() != existing.GetHttpLoadBalancing().GetDisabled() {
+		return false
+	}
+	if desired.HorizontalPodAutoscaling != nil && desired.GetHorizontalPodAutoscaling().GetDisabled() != existing.GetHorizontalPodAutoscaling().GetDisabled() {
+		return false
+	}
+	if desired.NetworkPolicyConfig != nil && desired.GetNetworkPolicyConfig().GetDisabled() != existing.GetNetworkPolicyConfig().GetDisabled() {
+		return false
+	}
+	if desired.GcePersistentDiskCsiDriverConfig != nil && desired.GetGcePersistentDiskCsiDriverConfig().GetEnabled() != existing.GetGcePersistentDiskCsiDriverConfig().GetEnabled() {
+		return false
+	}
+	if desired.GcpFilestoreCsiDriverConfig != nil && desired.GetGcpFilestoreCsiDriverConfig().GetEnabled() != existing.GetGcpFilestoreCsiDriverConfig().GetEnabled() {
+		return false
+	}
+	if desired.GcsFuseCsiDriverConfig != nil && desired.GetGcsFuseCsiDriverConfig().GetEnabled() != existing.GetGcsFuseCsiDriverConfig().GetEnabled() {
+		return false
+	}
+	if desired.DnsCacheConfig != nil && desired.GetDnsCacheConfig().GetEnabled() != existing.GetDnsCacheConfig().GetEnabled() {
+		return false
+	}
+	if desired.ConfigConnectorConfig != nil && desired.GetConfigConnectorConfig().GetEnabled() != existing.GetConfigConnectorConfig().GetEnabled() {
+		return false
+	}
+	if desired.GkeBackupAgentConfig != nil && desired.GetGkeBackupAgentConfig().GetEnabled() != existing.GetGkeBackupAgentConfig().GetEnabled() {
+		return false
+	}
+
+	return true
+}
+
+// checkDiffAndPrepareNetworkPolicy compares the network policy enforcement of the existing cluster with the
+// network policy add-on of the spec. GKE requires the add-on to be enabled before enforcement, and enforcement
+// to be disabled before the add-on, so enforcement is only enabled once the add-on is enabled on the cluster.
+func (s *Service) checkDiffAndPrepareNetworkPolicy(existingCluster *containerpb.Cluster, log *logr.Logger) (bool, *containerpb.SetNetworkPolicyRequest) {
+	config := s.scope.GCPManagedControlPlane.Spec.AddonsConfig
+	if config == nil || config.NetworkPolicy == nil {
+		return false, nil
+	}
+
+	desiredEnabled := *config.NetworkPolicy
+	existingEnabled := existingCluster.GetNetworkPolicy().GetEnabled()
+	if desiredEnabled == existingEnabled {
+		return false, nil
+	}
+	if desiredEnabled && existingCluster.GetAddonsConfig().GetNetworkPolicyConfig().GetDisabled() {
+		log.V(2).Info("Waiting for the network policy add-on before enabling network policy enforcement")
+		return false, nil
+	}
+	log.V(2).Info("Network policy enforcement update required", "current", existingEnabled, "desired", desiredEnabled)
+
+	networkPolicy := &containerpb.NetworkPolicy{}
+	if desiredEnabled {
+		networkPolicy.Provider = containerpb.NetworkPolicy_CALICO
+		networkPolicy.Enabled = true
+	}
+
+	return true, &containerpb.SetNetworkPolicyRequest{
+		Name:          s.scope.ClusterFullName(),
+		NetworkPolicy: networkPolicy,
+	}
+}
+
+func (s *Service) setNetworkPolicy(ctx context.Context, setNetworkPolicyRequest *containerpb.SetNetworkPolicyRequest, log *logr.Logger) error {
+	operation, err := s.scope.ManagedControlPlaneClient().SetNetworkPolicy(ctx, setNetworkPolicyRequest)
+	if err != nil {
+		log.Error(err, "Error setting GKE cluster network policy", "name", s.scope.ClusterName())
+		return err
+	}
+	s.setOperation(operation)
+
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestCompareAddonsConfig(t *testing.T) {
+	existing := &containerpb.AddonsConfig{
+		HttpLoadBalancing:                &containerpb.HttpLoadBalancing{},
+		HorizontalPodAutoscaling:         &containerpb.HorizontalPodAutoscaling{},
+		NetworkPolicyConfig:              &containerpb.NetworkPolicyConfig{Disabled: true},
+		GcePersistentDiskCsiDriverConfig: &containerpb.GcePersistentDiskCsiDriverConfig{Enabled: true},
+	}
+
+	tests := []struct {
+		name    string
+		desired *infrav1exp.AddonsConfig
+		want    bool
+	}{
+		{
+			name:    "no add-ons configured",
+			desired: nil,
+			want:    true,
+		},
+		{
+			name: "add-ons match",
+			desired: &infrav1exp.AddonsConfig{
+				HTTPLoadBalancing:          ptr.To(true),
+				NetworkPolicy:              ptr.To(false),
+				GcePersistentDiskCsiDriver: ptr.To(true),
+			},
+			want: true,
+		},
+		{
+			name: "add-on disabled",
+			desired: &infrav1exp.AddonsConfig{
+				HTTPLoadBalancing: ptr.To(false),
+			},
+			want: false,
+		},
+		{
+			name: "add-on missing from the live cluster enabled",
+			desired: &infrav1exp.AddonsConfig{
+				GcsFuseCsiDriver: ptr.To(true),
+			},
+			want: false,
+		},
+		{
+			name: "add-on missing from the live cluster disabled",
+			desired: &infrav1exp.AddonsConfig{
+				GkeBackupAgent: ptr.To(false),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareAddonsConfig(convertToSdkAddonsConfig(tt.desired), existing); got != tt.want {
+				t.Errorf("compareAddonsConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckDiffAndPrepareNetworkPolicy(t *testing.T) {
+	enforced := &containerpb.NetworkPolicy{Provider: containerpb.NetworkPolicy_CALICO, Enabled: true}
+	addonEnabled := &containerpb.AddonsConfig{NetworkPolicyConfig: &containerpb.NetworkPolicyConfig{}}
+	addonDisabled := &containerpb.AddonsConfig{NetworkPolicyConfig: &containerpb.NetworkPolicyConfig{Disabled: true}}
+
+	tests := []struct {
+		name              string
+		networkPolicy     *bool
+		cluster           *containerpb.Cluster
+		wantNetworkPolicy *containerpb.NetworkPolicy
+	}{
+		{
+			name:    "add-on not specified",
+			cluster: &containerpb.Cluster{AddonsConfig: addonEnabled, NetworkPolicy: enforced},
+		},
+		{
+			name:          "enforcement matches the add-on",
+			networkPolicy: ptr.To(true),
+			cluster:       &containerpb.Cluster{AddonsConfig: addonEnabled, NetworkPolicy: enforced},
+		},
+		{
+			name:          "enabling waits for the add-on",
+			networkPolicy: ptr.To(true),
+			cluster:       &containerpb.Cluster{AddonsConfig: addonDisabled},
+		},
+		{
+			name:              "enabling once the add-on is enabled",
+			networkPolicy:     ptr.To(true),
+			cluster:           &containerpb.Cluster{AddonsConfig: addonEnabled},
+			wantNetworkPolicy: enforced,
+		},
+		{
+			name:              "disabling before the add-on",
+			networkPolicy:     ptr.To(false),
+			cluster:           &containerpb.Cluster{AddonsConfig: addonEnabled, NetworkPolicy: enforced},
+			wantNetworkPolicy: &containerpb.NetworkPolicy{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(&scope.ManagedControlPlaneScope{
+				GCPManagedControlPlane: &infrav1exp.GCPManagedControlPlane{
+					Spec: infrav1exp.GCPManagedControlPlaneSpec{
+						AddonsConfig: &infrav1exp.AddonsConfig{NetworkPolicy: tt.networkPolicy},
+					},
+				},
+			})
+			log := logr.Discard()
+			needUpdate, request := s.checkDiffAndPrepareNetworkPolicy(tt.cluster, &log)
+			if needUpdate != (tt.wantNetworkPolicy != nil) {
+				t.Fatalf("checkDiffAndPrepareNetworkPolicy() needUpdate = %t, want %t", needUpdate, tt.wantNetworkPolicy != nil)
+			}
+			if d := cmp.Diff(tt.wantNetworkPolicy, request.GetNetworkPolicy(), protocmp.Transform()); d != "" {
+				t.Errorf("checkDiffAndPrepareNetworkPolicy() network policy mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+// convertToSdkAutopilot converts the autopilot settings to the SDK version.
+func convertToSdkAutopilot(enabled bool, config *infrav1exp.AutopilotConfig) *containerpb.Autopilot {
+	autopilot := &containerpb.Autopilot{
+		Enabled: enabled,
+	}
+	if config != nil && config.WorkloadPolicies != nil {
+		autopilot.WorkloadPolicyConfig = &containerpb.WorkloadPolicyConfig{
+			AllowNetAdmin: ptr.To(config.WorkloadPolicies.AllowNetAdmin),
+		}
+	}
+
+	return autopilot
+}
+
+// convertToSdkNodePoolAutoConfig converts the settings of the nodes GKE provisions for an autopilot cluster to the
+// SDK version.
+func convertToSdkNodePoolAutoConfig(config *infrav1exp.AutopilotConfig) *containerpb.NodePoolAutoConfig {
+	if config == nil || len(config.ResourceManagerTags) == 0 {
+		return nil
+	}
+
+	return &containerpb.NodePoolAutoConfig{
+		ResourceManagerTags: &containerpb.ResourceManagerTags{
+			Tags: config.ResourceManagerTags,
+		},
+	}
+}
+
+// convertToSdkAutopilotAutoscaling converts the node service account of an autopilot cluster to the SDK version.
+func convertToSdkAutopilotAutoscaling(config *infrav1exp.AutopilotConfig) *containerpb.ClusterAutoscaling {
+	if config == nil || config.NodeServiceAccount == "" {
+		return nil
+	}
+
+	return &containerpb.ClusterAutoscaling{
+		AutoprovisioningNodePoolDefaults: &containerpb.AutoprovisioningNodePoolDefaults{
+			ServiceAccount: config.NodeServiceAccount,
+		},
+	}
+}
+
+// convertToSdkSecretManagerConfig converts the Secret Manager add-on setting to the SDK version.
+func convertToSdkSecretManagerConfig(config *infrav1exp.AddonsConfig) *containerpb.SecretManagerConfig {
+	if config == nil || config.SecretManager == nil {
+		return nil
+	}
+
+	return &containerpb.SecretManagerConfig{
+		Enabled: config.SecretManager,
+	}
+}
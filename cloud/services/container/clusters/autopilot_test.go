@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestConvertToSdkAutopilotConfig(t *testing.T) {
+	tests := []struct {
+		name                   string
+		config                 *infrav1exp.AutopilotConfig
+		wantAutopilot          *containerpb.Autopilot
+		wantNodePoolAutoConfig *containerpb.NodePoolAutoConfig
+		wantAutoscaling        *containerpb.ClusterAutoscaling
+	}{
+		{
+			name:          "not specified",
+			wantAutopilot: &containerpb.Autopilot{Enabled: true},
+		},
+		{
+			name: "all settings",
+			config: &infrav1exp.AutopilotConfig{
+				WorkloadPolicies: &infrav1exp.AutopilotWorkloadPolicies{
+					AllowNetAdmin: true,
+				},
+				NodeServiceAccount:  "nodes@my-project.iam.gserviceaccount.com",
+				ResourceManagerTags: map[string]string{"tagKeys/123": "tagValues/456"},
+			},
+			wantAutopilot: &containerpb.Autopilot{
+				Enabled: true,
+				WorkloadPolicyConfig: &containerpb.WorkloadPolicyConfig{
+					AllowNetAdmin: ptr.To(true),
+				},
+			},
+			wantNodePoolAutoConfig: &containerpb.NodePoolAutoConfig{
+				ResourceManagerTags: &containerpb.ResourceManagerTags{
+					Tags: map[string]string{"tagKeys/123": "tagValues/456"},
+				},
+			},
+			wantAutoscaling: &containerpb.ClusterAutoscaling{
+				AutoprovisioningNodePoolDefaults: &containerpb.AutoprovisioningNodePoolDefaults{
+					ServiceAccount: "nodes@my-project.iam.gserviceaccount.com",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.wantAutopilot, convertToSdkAutopilot(true, tt.config), protocmp.Transform()); diff != "" {
+				t.Errorf("convertToSdkAutopilot() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantNodePoolAutoConfig, convertToSdkNodePoolAutoConfig(tt.config), protocmp.Transform()); diff != "" {
+				t.Errorf("convertToSdkNodePoolAutoConfig() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantAutoscaling, convertToSdkAutopilotAutoscaling(tt.config), protocmp.Transform()); diff != "" {
+				t.Errorf("convertToSdkAutopilotAutoscaling() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"slices"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+// convertToSdkClusterAutoscaling converts the ClusterAutoscaling defined in CRs to the SDK version.
+func convertToSdkClusterAutoscaling(config *infrav1exp.ClusterAutoscaling) *containerpb.ClusterAutoscaling {
+	if config == nil {
+		return nil
+	}
+
+	sdkConfig := &containerpb.ClusterAutoscaling{
+		EnableNodeAutoprovisioning: config.EnableNodeAutoprovisioning,
+		AutoprovisioningLocations:  config.AutoprovisioningLocations,
+	}
+	if config.AutoscalingProfile != nil {
+		switch *config.AutoscalingProfile {
+		case infrav1exp.AutoscalingProfileOptimizeUtilization:
+			sdkConfig.AutoscalingProfile = containerpb.ClusterAutoscaling_OPTIMIZE_UTILIZATION
+		case infrav1exp.AutoscalingProfileBalanced:
+			sdkConfig.AutoscalingProfile = containerpb.ClusterAutoscaling_BALANCED
+		}
+	}
+	for _, limit := range config.ResourceLimits {
+		sdkConfig.ResourceLimits = append(sdkConfig.ResourceLimits, &containerpb.ResourceLimit{
+			ResourceType: limit.ResourceType,
+			Minimum:      limit.Minimum,
+			Maximum:      limit.Maximum,
+		})
+	}
+	if defaults := config.AutoprovisioningNodePoolDefaults; defaults != nil {
+		sdkDefaults := &containerpb.AutoprovisioningNodePoolDefaults{
+			OauthScopes: defaults.OAuthScopes,
+		}
+		if defaults.ServiceAccount != nil {
+			sdkDefaults.ServiceAccount = *defaults.ServiceAccount
+		}
+		if defaults.ImageType != nil {
+			sdkDefaults.ImageType = *defaults.ImageType
+		}
+		if defaults.DiskSizeGb != nil {
+			sdkDefaults.DiskSizeGb = *defaults.DiskSizeGb
+		}
+		if defaults.DiskType != nil {
+			sdkDefaults.DiskType = string(*defaults.DiskType)
+		}
+		if defaults.BootDiskKMSKey != nil {
+			sdkDefaults.BootDiskKmsKey = *defaults.BootDiskKMSKey
+		}
+		sdkConfig.AutoprovisioningNodePoolDefaults = sdkDefaults
+	}
+
+	return sdkConfig
+}
+
+// compareClusterAutoscaling returns true if the cluster autoscaling of the live cluster matches desired.
+// The profile, locations and node pool defaults are only compared when specified.
+func compareClusterAutoscaling(desired, existing *containerpb.ClusterAutoscaling) bool {
+	if desired == nil {
+		return true
+	}
+
+	if desired.GetAutoscalingProfile() != containerpb.ClusterAutoscaling_PROFILE_UNSPECIFIED {
+		existingProfile := existing.GetAutoscalingProfile()
+		if existingProfile == containerpb.ClusterAutoscaling_PROFILE_UNSPECIFIED {
+			existingProfile = containerpb.ClusterAutoscaling_BALANCED
+		}
+		if desired.GetAutoscalingProfile() != existingProfile {
+			return false
+		}
+	}
+	if desired.GetEnableNodeAutoprovisioning() != existing.GetEnableNodeAutoprovisioning() {
+		return false
+	}
+	if !compareResourceLimits(desired.GetResourceLimits(), existing.GetResourceLimits()) {
+		return false
+	}
+	if len(desired.GetAutoprovisioningLocations()) > 0 &&
+		!compareComponents(desired.GetAutoprovisioningLocations(), existing.GetAutoprovisioningLocations()) {
+		return false
+	}
+
+	desiredDefaults, existingDefaults := desired.GetAutoprovisioningNodePoolDefaults(), existing.GetAutoprovisioningNodePoolDefaults()
+	if desiredDefaults == nil {
+		return true
+	}
+	if desiredDefaults.GetServiceAccount() != "" && desiredDefaults.GetServiceAccount() != existingDefaults.GetServiceAccount() {
+		return false
+	}
+	if len(desiredDefaults.GetOauthScopes()) > 0 && !compareComponents(desiredDefaults.GetOauthScopes(), existingDefaults.GetOauthScopes()) {
+		return false
+	}
+	if desiredDefaults.GetImageType() != "" && desiredDefaults.GetImageType() != existingDefaults.GetImageType() {
+		return false
+	}
+	if desiredDefaults.GetDiskSizeGb() != 0 && desiredDefaults.GetDiskSizeGb() != existingDefaults.GetDiskSizeGb() {
+		return false
+	}
+	if desiredDefaults.GetDiskType() != "" && desiredDefaults.GetDiskType() != existingDefaults.GetDiskType() {
+		return false
+	}
+	if desiredDefaults.GetBootDiskKmsKey() != "" && desiredDefaults.GetBootDiskKmsKey() != existingDefaults.GetBootDiskKmsKey() {
+		return false
+	}
+
+	return true
+}
+
+// compareResourceLimits returns true if both lists limit the same resources to the same amounts, regardless of order.
+func compareResourceLimits(desired, existing []*containerpb.ResourceLimit) bool {
+	if len(desired) != len(existing) {
+		return false
+	}
+
+	for _, limit := range desired {
+		if !slices.ContainsFunc(existing, func(l *containerpb.ResourceLimit) bool {
+			return l.GetResourceType() == limit.GetResourceType() && l.GetMinimum() == limit.GetMinimum() && l.GetMaximum() == limit.GetMaximum()
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestCompareClusterAutoscaling(t *testing.T) {
+	live := &containerpb.ClusterAutoscaling{
+		EnableNodeAutoprovisioning: true,
+		ResourceLimits: []*containerpb.ResourceLimit{
+			{ResourceType: "memory", Maximum: 400},
+			{ResourceType: "cpu", Maximum: 100},
+		},
+		AutoprovisioningLocations: []string{"us-central1-a", "us-central1-b"},
+		AutoprovisioningNodePoolDefaults: &containerpb.AutoprovisioningNodePoolDefaults{
+			ServiceAccount: "default",
+			OauthScopes:    []string{"https://www.googleapis.com/auth/cloud-platform"},
+			ImageType:      "COS_CONTAINERD",
+			DiskSizeGb:     100,
+		},
+	}
+	limits := []infrav1exp.ResourceLimit{
+		{ResourceType: "cpu", Maximum: 100},
+		{ResourceType: "memory", Maximum: 400},
+	}
+
+	tests := []struct {
+		name    string
+		desired *infrav1exp.ClusterAutoscaling
+		want    bool
+	}{
+		{
+			name:    "not specified",
+			desired: nil,
+			want:    true,
+		},
+		{
+			name: "same limits with GKE defaults",
+			desired: &infrav1exp.ClusterAutoscaling{
+				AutoscalingProfile:         ptr.To(infrav1exp.AutoscalingProfileBalanced),
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits:             limits,
+				AutoprovisioningNodePoolDefaults: &infrav1exp.AutoprovisioningNodePoolDefaults{
+					ImageType: ptr.To("COS_CONTAINERD"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "profile changed",
+			desired: &infrav1exp.ClusterAutoscaling{
+				AutoscalingProfile:         ptr.To(infrav1exp.AutoscalingProfileOptimizeUtilization),
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits:             limits,
+			},
+			want: false,
+		},
+		{
+			name: "GPU limit added",
+			desired: &infrav1exp.ClusterAutoscaling{
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits: append([]infrav1exp.ResourceLimit{
+					{ResourceType: "nvidia-tesla-t4", Maximum: 4},
+				}, limits...),
+			},
+			want: false,
+		},
+		{
+			name: "node auto-provisioning disabled",
+			desired: &infrav1exp.ClusterAutoscaling{
+				AutoscalingProfile: ptr.To(infrav1exp.AutoscalingProfileBalanced),
+			},
+			want: false,
+		},
+		{
+			name: "node pool defaults changed",
+			desired: &infrav1exp.ClusterAutoscaling{
+				EnableNodeAutoprovisioning: true,
+				ResourceLimits:             limits,
+				AutoprovisioningNodePoolDefaults: &infrav1exp.AutoprovisioningNodePoolDefaults{
+					ServiceAccount: ptr.To("nodes@my-project.iam.gserviceaccount.com"),
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareClusterAutoscaling(convertToSdkClusterAutoscaling(tt.desired), live); got != tt.want {
+				t.Errorf("compareClusterAutoscaling() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
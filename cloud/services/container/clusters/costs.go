@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"cloud.google.com/go/container/apiv1/containerpb"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+// convertToSdkResourceUsageExportConfig converts the resource usage export settings to the SDK version.
+func convertToSdkResourceUsageExportConfig(config *infrav1exp.ResourceUsageExportConfig) *containerpb.ResourceUsageExportConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &containerpb.ResourceUsageExportConfig{
+		BigqueryDestination: &containerpb.ResourceUsageExportConfig_BigQueryDestination{
+			DatasetId: config.BigQueryDatasetID,
+		},
+		EnableNetworkEgressMetering: config.EnableNetworkEgressMetering,
+		ConsumptionMeteringConfig: &containerpb.ResourceUsageExportConfig_ConsumptionMeteringConfig{
+			Enabled: config.EnableConsumptionMetering,
+		},
+	}
+}
+
+// convertToSdkCostManagementConfig converts the cost allocation setting to the SDK version.
+func convertToSdkCostManagementConfig(enabled *bool) *containerpb.CostManagementConfig {
+	if enabled == nil {
+		return nil
+	}
+
+	return &containerpb.CostManagementConfig{
+		Enabled: *enabled,
+	}
+}
+
+// compareResourceUsageExportConfig returns true if the live cluster exports its resource usage as desired.
+func compareResourceUsageExportConfig(desired, existing *containerpb.ResourceUsageExportConfig) bool {
+	if desired == nil {
+		return true
+	}
+
+	return desired.GetBigqueryDestination().GetDatasetId() == existing.GetBigqueryDestination().GetDatasetId() &&
+		desired.GetEnableNetworkEgressMetering() == existing.GetEnableNetworkEgressMetering() &&
+		desired.GetConsumptionMeteringConfig().GetEnabled() == existing.GetConsumptionMeteringConfig().GetEnabled()
+}
+
+// compareCostManagementConfig returns true if cost allocation is enabled on the live cluster as desired.
+func compareCostManagementConfig(desired, existing *containerpb.CostManagementConfig) bool {
+	if desired == nil {
+		return true
+	}
+
+	return desired.GetEnabled() == existing.GetEnabled()
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestConvertToSdkResourceUsageExportConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *infrav1exp.ResourceUsageExportConfig
+		want   *containerpb.ResourceUsageExportConfig
+	}{
+		{
+			name: "not specified",
+		},
+		{
+			name: "all settings",
+			config: &infrav1exp.ResourceUsageExportConfig{
+				BigQueryDatasetID:           "gke_usage",
+				EnableNetworkEgressMetering: true,
+				EnableConsumptionMetering:   true,
+			},
+			want: &containerpb.ResourceUsageExportConfig{
+				BigqueryDestination:         &containerpb.ResourceUsageExportConfig_BigQueryDestination{DatasetId: "gke_usage"},
+				EnableNetworkEgressMetering: true,
+				ConsumptionMeteringConfig:   &containerpb.ResourceUsageExportConfig_ConsumptionMeteringConfig{Enabled: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, convertToSdkResourceUsageExportConfig(tt.config), protocmp.Transform()); diff != "" {
+				t.Errorf("convertToSdkResourceUsageExportConfig() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompareResourceUsageExportConfig(t *testing.T) {
+	existing := &containerpb.ResourceUsageExportConfig{
+		BigqueryDestination:       &containerpb.ResourceUsageExportConfig_BigQueryDestination{DatasetId: "gke_usage"},
+		ConsumptionMeteringConfig: &containerpb.ResourceUsageExportConfig_ConsumptionMeteringConfig{Enabled: true},
+	}
+	tests := []struct {
+		name    string
+		desired *infrav1exp.ResourceUsageExportConfig
+		want    bool
+	}{
+		{
+			name: "not specified",
+			want: true,
+		},
+		{
+			name:    "matching",
+			desired: &infrav1exp.ResourceUsageExportConfig{BigQueryDatasetID: "gke_usage", EnableConsumptionMetering: true},
+			want:    true,
+		},
+		{
+			name:    "different dataset",
+			desired: &infrav1exp.ResourceUsageExportConfig{BigQueryDatasetID: "finance", EnableConsumptionMetering: true},
+		},
+		{
+			name:    "egress metering enabled",
+			desired: &infrav1exp.ResourceUsageExportConfig{BigQueryDatasetID: "gke_usage", EnableNetworkEgressMetering: true, EnableConsumptionMetering: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareResourceUsageExportConfig(convertToSdkResourceUsageExportConfig(tt.desired), existing); got != tt.want {
+				t.Errorf("compareResourceUsageExportConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareCostManagementConfig(t *testing.T) {
+	if !compareCostManagementConfig(convertToSdkCostManagementConfig(nil), nil) {
+		t.Errorf("compareCostManagementConfig() = false, want true when not specified")
+	}
+	if compareCostManagementConfig(convertToSdkCostManagementConfig(ptr.To(true)), nil) {
+		t.Errorf("compareCostManagementConfig() = true, want false when enabling cost allocation")
+	}
+	if !compareCostManagementConfig(convertToSdkCostManagementConfig(ptr.To(false)), &containerpb.CostManagementConfig{}) {
+		t.Errorf("compareCostManagementConfig() = false, want true when cost allocation is disabled")
+	}
+}
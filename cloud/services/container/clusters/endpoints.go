@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+// convertToSdkDNSEndpointConfig converts the DNS endpoint configuration. A nil configuration disallows external
+// traffic over the DNS endpoint.
+func convertToSdkDNSEndpointConfig(config *infrav1exp.DNSEndpointConfig) *containerpb.ControlPlaneEndpointsConfig_DNSEndpointConfig {
+	allowExternalTraffic := config != nil && config.AllowExternalTraffic
+	return &containerpb.ControlPlaneEndpointsConfig_DNSEndpointConfig{
+		AllowExternalTraffic: &allowExternalTraffic,
+	}
+}
+
+// controlPlaneEndpoint returns the host of the control plane endpoint selected by the spec and the CA
+// certificate to verify it with. The CA certificate is nil for the DNS endpoint, which uses a publicly
+// trusted certificate. ErrControlPlaneEndpointNotFound is returned when the selected endpoint does not exist
+// on the cluster, e.g. the private endpoint of a public cluster.
+func controlPlaneEndpoint(cluster *containerpb.Cluster, endpointType *infrav1exp.ControlPlaneEndpointType) (string, []byte, error) {
+	host := cluster.GetEndpoint()
+	if endpointType != nil {
+		switch *endpointType {
+		case infrav1exp.ControlPlaneEndpointPublicIP:
+			host = cluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetPublicEndpoint()
+			if host == "" {
+				host = cluster.GetPrivateClusterConfig().GetPublicEndpoint()
+			}
+		case infrav1exp.ControlPlaneEndpointPrivateIP:
+			host = cluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetPrivateEndpoint()
+			if host == "" {
+				host = cluster.GetPrivateClusterConfig().GetPrivateEndpoint()
+			}
+		case infrav1exp.ControlPlaneEndpointDNS:
+			host = cluster.GetControlPlaneEndpointsConfig().GetDnsEndpointConfig().GetEndpoint()
+		default:
+			return "", nil, errors.Errorf("unsupported control plane endpoint type %q", *endpointType)
+		}
+	}
+	if host == "" {
+		return "", nil, errors.Wrapf(ErrControlPlaneEndpointNotFound, "endpoint type %s", ptr.Deref(endpointType, "default"))
+	}
+	if ptr.Deref(endpointType, "") == infrav1exp.ControlPlaneEndpointDNS {
+		return host, nil, nil
+	}
+
+	certData, err := base64.StdEncoding.DecodeString(cluster.GetMasterAuth().GetClusterCaCertificate())
+	if err != nil {
+		return "", nil, fmt.Errorf("decoding cluster CA cert: %w", err)
+	}
+
+	return host, certData, nil
+}
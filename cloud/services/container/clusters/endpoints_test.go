@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestControlPlaneEndpoint(t *testing.T) {
+	cluster := &containerpb.Cluster{
+		Endpoint: "34.1.2.3",
+		MasterAuth: &containerpb.MasterAuth{
+			ClusterCaCertificate: base64.StdEncoding.EncodeToString([]byte("ca")),
+		},
+		ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				PublicEndpoint:  "34.1.2.3",
+				PrivateEndpoint: "10.0.0.2",
+			},
+			DnsEndpointConfig: &containerpb.ControlPlaneEndpointsConfig_DNSEndpointConfig{
+				Endpoint: "gke-0123456789.us-central1.gke.goog",
+			},
+		},
+	}
+
+	publicCluster := &containerpb.Cluster{
+		Endpoint: "34.1.2.3",
+		ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				PublicEndpoint: "34.1.2.3",
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		cluster      *containerpb.Cluster
+		endpointType *infrav1exp.ControlPlaneEndpointType
+		wantHost     string
+		wantCA       bool
+		wantNotFound bool
+	}{
+		{
+			name:     "default endpoint",
+			wantHost: "34.1.2.3",
+			wantCA:   true,
+		},
+		{
+			name:         "public IP endpoint",
+			endpointType: ptr.To(infrav1exp.ControlPlaneEndpointPublicIP),
+			wantHost:     "34.1.2.3",
+			wantCA:       true,
+		},
+		{
+			name:         "private IP endpoint",
+			endpointType: ptr.To(infrav1exp.ControlPlaneEndpointPrivateIP),
+			wantHost:     "10.0.0.2",
+			wantCA:       true,
+		},
+		{
+			name:         "DNS endpoint",
+			endpointType: ptr.To(infrav1exp.ControlPlaneEndpointDNS),
+			wantHost:     "gke-0123456789.us-central1.gke.goog",
+			wantCA:       false,
+		},
+		{
+			name:         "private IP endpoint of a public cluster",
+			cluster:      publicCluster,
+			endpointType: ptr.To(infrav1exp.ControlPlaneEndpointPrivateIP),
+			wantNotFound: true,
+		},
+		{
+			name:         "DNS endpoint not enabled",
+			cluster:      publicCluster,
+			endpointType: ptr.To(infrav1exp.ControlPlaneEndpointDNS),
+			wantNotFound: true,
+		},
+		{
+			name:         "no endpoint yet",
+			cluster:      &containerpb.Cluster{},
+			wantNotFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := cluster
+			if tt.cluster != nil {
+				cluster = tt.cluster
+			}
+			host, ca, err := controlPlaneEndpoint(cluster, tt.endpointType)
+			if tt.wantNotFound {
+				if !errors.Is(err, ErrControlPlaneEndpointNotFound) {
+					t.Fatalf("controlPlaneEndpoint() error = %v, want %v", err, ErrControlPlaneEndpointNotFound)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("controlPlaneEndpoint() error = %v", err)
+			}
+			if host != tt.wantHost {
+				t.Errorf("controlPlaneEndpoint() host = %q, want %q", host, tt.wantHost)
+			}
+			if (ca != nil) != tt.wantCA {
+				t.Errorf("controlPlaneEndpoint() CA = %q, want CA %v", ca, tt.wantCA)
+			}
+		})
+	}
+}
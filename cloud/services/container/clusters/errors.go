@@ -23,6 +23,9 @@ import (
 // ErrAutopilotClusterMachinePoolsNotAllowed is used when there are machine pools specified for an autopilot enabled cluster.
 var ErrAutopilotClusterMachinePoolsNotAllowed = errors.New("cannot use machine pools with an autopilot enabled cluster")
 
+// ErrControlPlaneEndpointNotFound is used when the control plane endpoint selected by the spec does not exist on the cluster.
+var ErrControlPlaneEndpointNotFound = errors.New("control plane endpoint not found on the GKE cluster")
+
 // NewErrUnexpectedClusterStatus creates a new error for an unexpected cluster status.
 func NewErrUnexpectedClusterStatus(status string) error {
 	return &UnexpectedClusterStatusError{status}
@@ -0,0 +1,352 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/gcperrors"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+const (
+	// configManagementFeature is the fleet feature providing Config Sync.
+	configManagementFeature = "configmanagement"
+	// policyControllerFeature is the fleet feature providing Policy Controller.
+	policyControllerFeature = "policycontroller"
+
+	membershipStateReady = "READY"
+)
+
+// fleetMembershipName returns the full resource name of the fleet membership of the cluster.
+func (s *Service) fleetMembershipName() string {
+	fleet := s.scope.GCPManagedControlPlane.Spec.Fleet
+	project := s.scope.GCPManagedControlPlane.Spec.Project
+	membership := s.scope.ClusterName()
+	if fleet != nil && fleet.Project != "" {
+		project = fleet.Project
+	}
+	if fleet != nil && fleet.MembershipName != "" {
+		membership = fleet.MembershipName
+	}
+
+	return fmt.Sprintf("projects/%s/locations/global/memberships/%s", project, membership)
+}
+
+// reconcileFleet registers the cluster to its fleet and configures the fleet features of its membership. It
+// returns true while the membership or a feature is still being set up.
+func (s *Service) reconcileFleet(ctx context.Context, log *logr.Logger) (bool, error) {
+	fleet := s.scope.GCPManagedControlPlane.Spec.Fleet
+	if fleet == nil {
+		if s.scope.GCPManagedControlPlane.Status.FleetMembership == "" {
+			return false, nil
+		}
+		// The fleet section was removed from the spec.
+		return false, s.unregisterFleetMembership(ctx, log)
+	}
+
+	name := s.fleetMembershipName()
+	if current := s.scope.GCPManagedControlPlane.Status.FleetMembership; current != "" && current != name {
+		if err := s.unregisterFleetMembership(ctx, log); err != nil {
+			return false, err
+		}
+	}
+
+	memberships := s.scope.GKEHubService().Projects.Locations.Memberships
+	membership, err := memberships.Get(name).Context(ctx).Do()
+	if err != nil && !gcperrors.IsNotFound(err) {
+		log.Error(err, "Error getting fleet membership", "name", name)
+		return false, err
+	}
+	if membership == nil {
+		log.Info("Registering cluster to fleet", "membership", name)
+		parent, membershipID := splitFleetResourceName(name)
+		if _, err := memberships.Create(parent, s.desiredFleetMembership()).MembershipId(membershipID).Context(ctx).Do(); err != nil {
+			log.Error(err, "Error registering cluster to fleet", "membership", name)
+			return false, err
+		}
+		s.scope.GCPManagedControlPlane.Status.FleetMembership = name
+		s.scope.GCPManagedControlPlane.Status.FleetMembershipCreated = true
+		return true, nil
+	}
+
+	if link := fleetMembershipResourceLink(membership); link != s.clusterResourceLink() {
+		return false, errors.Errorf("fleet membership %s is registered to a different cluster %q", name, link)
+	}
+	if s.scope.GCPManagedControlPlane.Status.FleetMembership != name {
+		log.Info("Adopting existing fleet membership", "membership", name)
+		s.scope.GCPManagedControlPlane.Status.FleetMembership = name
+		s.scope.GCPManagedControlPlane.Status.FleetMembershipCreated = false
+	}
+	if membership.State == nil || membership.State.Code != membershipStateReady {
+		log.Info("Fleet membership is not ready yet", "membership", name)
+		return true, nil
+	}
+
+	pending := false
+	if fleet.ConfigSync != nil {
+		featurePending, err := s.reconcileFleetFeature(ctx, configManagementFeature, membership.Name, &gkehub.MembershipFeatureSpec{
+			Configmanagement: convertToSdkConfigManagementMembershipSpec(fleet.ConfigSync),
+		}, log)
+		if err != nil {
+			return false, err
+		}
+		pending = pending || featurePending
+	}
+	if fleet.PolicyController != nil {
+		featurePending, err := s.reconcileFleetFeature(ctx, policyControllerFeature, membership.Name, &gkehub.MembershipFeatureSpec{
+			Policycontroller: convertToSdkPolicyControllerMembershipSpec(fleet.PolicyController),
+		}, log)
+		if err != nil {
+			return false, err
+		}
+		pending = pending || featurePending
+	}
+
+	return pending, nil
+}
+
+// reconcileFleetFeature enables a fleet feature on the fleet host project and applies the desired spec to the
+// membership. It returns true while the feature is being enabled.
+func (s *Service) reconcileFleetFeature(ctx context.Context, featureID, membershipName string, desired *gkehub.MembershipFeatureSpec, log *logr.Logger) (bool, error) {
+	parent, _ := splitFleetResourceName(s.fleetMembershipName())
+	name := fmt.Sprintf("%s/features/%s", parent, featureID)
+	features := s.scope.GKEHubService().Projects.Locations.Features
+
+	feature, err := features.Get(name).Context(ctx).Do()
+	if err != nil && !gcperrors.IsNotFound(err) {
+		log.Error(err, "Error getting fleet feature", "name", name)
+		return false, err
+	}
+	if feature == nil {
+		log.Info("Enabling fleet feature", "feature", featureID)
+		if _, err := features.Create(parent, &gkehub.Feature{}).FeatureId(featureID).Context(ctx).Do(); err != nil {
+			log.Error(err, "Error enabling fleet feature", "feature", featureID)
+			return false, err
+		}
+		return true, nil
+	}
+
+	if current, ok := membershipFeatureSpec(feature, membershipName); ok && compareMembershipFeatureSpec(desired, &current) {
+		return false, nil
+	}
+
+	log.V(2).Info("Fleet feature update required", "feature", featureID, "desired", desired)
+	patch := &gkehub.Feature{
+		MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{
+			membershipName: *desired,
+		},
+	}
+	if _, err := features.Patch(name, patch).UpdateMask("membershipSpecs").Context(ctx).Do(); err != nil {
+		log.Error(err, "Error updating fleet feature", "feature", featureID)
+		return false, err
+	}
+
+	return false, nil
+}
+
+// unregisterFleetMembership deletes the fleet membership recorded in the status if it was created by Cluster API.
+// Adopted memberships are left in place and only forgotten.
+func (s *Service) unregisterFleetMembership(ctx context.Context, log *logr.Logger) error {
+	name := s.scope.GCPManagedControlPlane.Status.FleetMembership
+	if name == "" {
+		return nil
+	}
+
+	if s.scope.GCPManagedControlPlane.Status.FleetMembershipCreated {
+		log.Info("Unregistering cluster from fleet", "membership", name)
+		_, err := s.scope.GKEHubService().Projects.Locations.Memberships.Delete(name).Context(ctx).Do()
+		if err := gcperrors.IgnoreNotFound(err); err != nil {
+			log.Error(err, "Error unregistering cluster from fleet", "membership", name)
+			return err
+		}
+	} else {
+		log.Info("Fleet membership was not created by Cluster API, leaving it in place", "membership", name)
+	}
+	s.scope.GCPManagedControlPlane.Status.FleetMembership = ""
+	s.scope.GCPManagedControlPlane.Status.FleetMembershipCreated = false
+
+	return nil
+}
+
+// desiredFleetMembership returns the fleet membership of the cluster.
+func (s *Service) desiredFleetMembership() *gkehub.Membership {
+	membership := &gkehub.Membership{
+		Endpoint: &gkehub.MembershipEndpoint{
+			GkeCluster: &gkehub.GkeCluster{
+				ResourceLink: s.clusterResourceLink(),
+			},
+		},
+	}
+	if s.scope.GCPManagedControlPlane.Spec.WorkloadIdentityConfig != nil {
+		membership.Authority = &gkehub.Authority{
+			Issuer: "https://container.googleapis.com/v1/" + s.scope.ClusterFullName(),
+		}
+	}
+
+	return membership
+}
+
+// clusterResourceLink returns the resource link of the cluster used by fleet memberships.
+func (s *Service) clusterResourceLink() string {
+	return "//container.googleapis.com/" + s.scope.ClusterFullName()
+}
+
+// fleetMembershipResourceLink returns the resource link of the cluster registered to the membership.
+func fleetMembershipResourceLink(membership *gkehub.Membership) string {
+	if membership.Endpoint == nil || membership.Endpoint.GkeCluster == nil {
+		return ""
+	}
+
+	return membership.Endpoint.GkeCluster.ResourceLink
+}
+
+// splitFleetResourceName splits the full name of a fleet resource into its parent location and its ID.
+func splitFleetResourceName(name string) (string, string) {
+	parts := strings.Split(name, "/")
+	return strings.Join(parts[:len(parts)-2], "/"), parts[len(parts)-1]
+}
+
+// membershipFeatureSpec returns the spec of the membership within the feature. GKE Hub reports the keys with the
+// project number, so the memberships are matched on their location and ID.
+func membershipFeatureSpec(feature *gkehub.Feature, membershipName string) (gkehub.MembershipFeatureSpec, bool) {
+	_, suffix, _ := strings.Cut(membershipName, "/locations/")
+	for key, spec := range feature.MembershipSpecs {
+		if strings.HasSuffix(key, "/locations/"+suffix) {
+			return spec, true
+		}
+	}
+
+	return gkehub.MembershipFeatureSpec{}, false
+}
+
+// convertToSdkConfigManagementMembershipSpec converts the Config Sync settings to the SDK version.
+func convertToSdkConfigManagementMembershipSpec(config *infrav1exp.ConfigSyncConfig) *gkehub.ConfigManagementMembershipSpec {
+	return &gkehub.ConfigManagementMembershipSpec{
+		Version: config.Version,
+		ConfigSync: &gkehub.ConfigManagementConfigSync{
+			Enabled:      true,
+			SourceFormat: config.SourceFormat,
+			PreventDrift: config.PreventDrift,
+			Git: &gkehub.ConfigManagementGitConfig{
+				SyncRepo:               config.Git.SyncRepo,
+				SyncBranch:             config.Git.SyncBranch,
+				SyncRev:                config.Git.SyncRev,
+				PolicyDir:              config.Git.PolicyDir,
+				SecretType:             config.Git.SecretType,
+				GcpServiceAccountEmail: config.Git.GCPServiceAccountEmail,
+			},
+		},
+	}
+}
+
+// convertToSdkPolicyControllerMembershipSpec converts the Policy Controller settings to the SDK version.
+func convertToSdkPolicyControllerMembershipSpec(config *infrav1exp.PolicyControllerConfig) *gkehub.PolicyControllerMembershipSpec {
+	templateLibrary := "ALL"
+	if config.TemplateLibraryDisabled {
+		templateLibrary = "NOT_INSTALLED"
+	}
+	hubConfig := &gkehub.PolicyControllerHubConfig{
+		InstallSpec:             "INSTALL_SPEC_ENABLED",
+		ExemptableNamespaces:    config.ExemptableNamespaces,
+		LogDeniesEnabled:        config.LogDeniesEnabled,
+		MutationEnabled:         config.MutationEnabled,
+		ReferentialRulesEnabled: config.ReferentialRulesEnabled,
+		PolicyContent: &gkehub.PolicyControllerPolicyContentSpec{
+			TemplateLibrary: &gkehub.PolicyControllerTemplateLibraryConfig{
+				Installation: templateLibrary,
+			},
+		},
+	}
+	if config.AuditIntervalSeconds != nil {
+		hubConfig.AuditIntervalSeconds = *config.AuditIntervalSeconds
+		// An interval of 0 disables audit and must be sent explicitly.
+		hubConfig.ForceSendFields = []string{"AuditIntervalSeconds"}
+	}
+
+	return &gkehub.PolicyControllerMembershipSpec{
+		Version:                   config.Version,
+		PolicyControllerHubConfig: hubConfig,
+	}
+}
+
+// compareMembershipFeatureSpec returns true if the current membership spec matches the desired one. Only the
+// settings managed by the GCPManagedControlPlane are compared, as GKE Hub fills in defaults for the others.
+func compareMembershipFeatureSpec(desired, current *gkehub.MembershipFeatureSpec) bool {
+	if desired.Configmanagement != nil && !compareConfigManagementMembershipSpec(desired.Configmanagement, current.Configmanagement) {
+		return false
+	}
+	if desired.Policycontroller != nil && !comparePolicyControllerMembershipSpec(desired.Policycontroller, current.Policycontroller) {
+		return false
+	}
+
+	return true
+}
+
+func compareConfigManagementMembershipSpec(desired, current *gkehub.ConfigManagementMembershipSpec) bool {
+	if current == nil || current.ConfigSync == nil || current.ConfigSync.Git == nil {
+		return false
+	}
+	if desired.Version != "" && desired.Version != current.Version {
+		return false
+	}
+	d, c := desired.ConfigSync, current.ConfigSync
+	if d.Enabled != c.Enabled || d.PreventDrift != c.PreventDrift {
+		return false
+	}
+	if d.SourceFormat != "" && d.SourceFormat != c.SourceFormat {
+		return false
+	}
+
+	return d.Git.SyncRepo == c.Git.SyncRepo &&
+		d.Git.SyncBranch == c.Git.SyncBranch &&
+		d.Git.SyncRev == c.Git.SyncRev &&
+		d.Git.PolicyDir == c.Git.PolicyDir &&
+		d.Git.SecretType == c.Git.SecretType &&
+		d.Git.GcpServiceAccountEmail == c.Git.GcpServiceAccountEmail
+}
+
+func comparePolicyControllerMembershipSpec(desired, current *gkehub.PolicyControllerMembershipSpec) bool {
+	if current == nil || current.PolicyControllerHubConfig == nil {
+		return false
+	}
+	if desired.Version != "" && desired.Version != current.Version {
+		return false
+	}
+	d, c := desired.PolicyControllerHubConfig, current.PolicyControllerHubConfig
+	if d.InstallSpec != c.InstallSpec ||
+		d.LogDeniesEnabled != c.LogDeniesEnabled ||
+		d.MutationEnabled != c.MutationEnabled ||
+		d.ReferentialRulesEnabled != c.ReferentialRulesEnabled ||
+		!slices.Equal(d.ExemptableNamespaces, c.ExemptableNamespaces) {
+		return false
+	}
+	if slices.Contains(d.ForceSendFields, "AuditIntervalSeconds") && d.AuditIntervalSeconds != c.AuditIntervalSeconds {
+		return false
+	}
+	if c.PolicyContent == nil || c.PolicyContent.TemplateLibrary == nil {
+		return false
+	}
+
+	return d.PolicyContent.TemplateLibrary.Installation == c.PolicyContent.TemplateLibrary.Installation
+}
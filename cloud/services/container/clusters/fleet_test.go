@@ -0,0 +1,246 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestConvertToSdkPolicyControllerMembershipSpec(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *infrav1exp.PolicyControllerConfig
+		want   *gkehub.PolicyControllerMembershipSpec
+	}{
+		{
+			name:   "defaults",
+			config: &infrav1exp.PolicyControllerConfig{},
+			want: &gkehub.PolicyControllerMembershipSpec{
+				PolicyControllerHubConfig: &gkehub.PolicyControllerHubConfig{
+					InstallSpec: "INSTALL_SPEC_ENABLED",
+					PolicyContent: &gkehub.PolicyControllerPolicyContentSpec{
+						TemplateLibrary: &gkehub.PolicyControllerTemplateLibraryConfig{Installation: "ALL"},
+					},
+				},
+			},
+		},
+		{
+			name: "audit disabled without template library",
+			config: &infrav1exp.PolicyControllerConfig{
+				Version:                 "1.18.0",
+				AuditIntervalSeconds:    ptr.To[int64](0),
+				ExemptableNamespaces:    []string{"kube-system"},
+				MutationEnabled:         true,
+				TemplateLibraryDisabled: true,
+			},
+			want: &gkehub.PolicyControllerMembershipSpec{
+				Version: "1.18.0",
+				PolicyControllerHubConfig: &gkehub.PolicyControllerHubConfig{
+					InstallSpec:          "INSTALL_SPEC_ENABLED",
+					ExemptableNamespaces: []string{"kube-system"},
+					MutationEnabled:      true,
+					PolicyContent: &gkehub.PolicyControllerPolicyContentSpec{
+						TemplateLibrary: &gkehub.PolicyControllerTemplateLibraryConfig{Installation: "NOT_INSTALLED"},
+					},
+					ForceSendFields: []string{"AuditIntervalSeconds"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, convertToSdkPolicyControllerMembershipSpec(tt.config)); diff != "" {
+				t.Errorf("convertToSdkPolicyControllerMembershipSpec() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompareMembershipFeatureSpec(t *testing.T) {
+	configSync := &infrav1exp.ConfigSyncConfig{
+		SourceFormat: "unstructured",
+		Git: infrav1exp.ConfigSyncGitConfig{
+			SyncRepo:   "https://github.com/example/config",
+			SyncBranch: "main",
+			SecretType: "none",
+		},
+	}
+	desired := &gkehub.MembershipFeatureSpec{
+		Configmanagement: convertToSdkConfigManagementMembershipSpec(configSync),
+	}
+
+	// GKE Hub fills in the version and management mode.
+	current := convertToSdkConfigManagementMembershipSpec(configSync)
+	current.Version = "1.19.0"
+	current.Management = "MANAGEMENT_AUTOMATIC"
+	if !compareMembershipFeatureSpec(desired, &gkehub.MembershipFeatureSpec{Configmanagement: current}) {
+		t.Errorf("compareMembershipFeatureSpec() = false, want true for server defaults")
+	}
+
+	current.ConfigSync.Git.SyncBranch = "release"
+	if compareMembershipFeatureSpec(desired, &gkehub.MembershipFeatureSpec{Configmanagement: current}) {
+		t.Errorf("compareMembershipFeatureSpec() = true, want false for a changed sync branch")
+	}
+
+	if compareMembershipFeatureSpec(desired, &gkehub.MembershipFeatureSpec{}) {
+		t.Errorf("compareMembershipFeatureSpec() = true, want false for a missing spec")
+	}
+}
+
+func TestMembershipFeatureSpec(t *testing.T) {
+	feature := &gkehub.Feature{
+		MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{
+			"projects/123456/locations/global/memberships/other": {},
+			"projects/123456/locations/global/memberships/my-cluster": {
+				Configmanagement: &gkehub.ConfigManagementMembershipSpec{Version: "1.19.0"},
+			},
+		},
+	}
+
+	spec, ok := membershipFeatureSpec(feature, "projects/my-project/locations/global/memberships/my-cluster")
+	if !ok || spec.Configmanagement == nil || spec.Configmanagement.Version != "1.19.0" {
+		t.Errorf("membershipFeatureSpec() = %v, %v, want the spec of my-cluster", spec, ok)
+	}
+
+	if _, ok := membershipFeatureSpec(feature, "projects/my-project/locations/global/memberships/missing"); ok {
+		t.Errorf("membershipFeatureSpec() found a spec for a missing membership")
+	}
+}
+
+func TestSplitFleetResourceName(t *testing.T) {
+	parent, id := splitFleetResourceName("projects/my-project/locations/global/memberships/my-cluster")
+	if parent != "projects/my-project/locations/global" || id != "my-cluster" {
+		t.Errorf("splitFleetResourceName() = %q, %q", parent, id)
+	}
+}
+
+// fakeGKEHub records the requests made to the GKE Hub API and answers them with an empty operation.
+type fakeGKEHub struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+func (f *fakeGKEHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"name": "operation-1"}`))
+}
+
+// newFakeGKEHubService returns a GKE Hub service sending its requests to the given fake.
+func newFakeGKEHubService(t *testing.T, hub *fakeGKEHub) *gkehub.Service {
+	t.Helper()
+	server := httptest.NewServer(hub)
+	t.Cleanup(server.Close)
+
+	service, err := gkehub.NewService(context.TODO(), option.WithoutAuthentication(), option.WithEndpoint(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
+
+func newFleetControlPlane(created bool) *infrav1exp.GCPManagedControlPlane {
+	return &infrav1exp.GCPManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-control-plane",
+			Namespace: "default",
+		},
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			ClusterName: "my-cluster",
+			Project:     "my-project",
+			Location:    "us-central1",
+			Import:      true,
+		},
+		Status: infrav1exp.GCPManagedControlPlaneStatus{
+			Imported:               true,
+			FleetMembership:        "projects/my-project/locations/global/memberships/my-cluster",
+			FleetMembershipCreated: created,
+		},
+	}
+}
+
+func TestUnregisterFleetMembership(t *testing.T) {
+	tests := []struct {
+		name         string
+		created      bool
+		wantRequests []string
+	}{
+		{
+			name:         "created by Cluster API",
+			created:      true,
+			wantRequests: []string{"DELETE /v1/projects/my-project/locations/global/memberships/my-cluster"},
+		},
+		{
+			name:    "adopted",
+			created: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := &fakeGKEHub{}
+			gcpManagedControlPlane := newFleetControlPlane(tt.created)
+			s := New(newTestManagedControlPlaneScope(t, &fakeClusterManager{}, gcpManagedControlPlane, newFakeGKEHubService(t, hub)))
+
+			log := logr.Discard()
+			if err := s.unregisterFleetMembership(context.TODO(), &log); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.wantRequests, hub.requests); diff != "" {
+				t.Errorf("unregisterFleetMembership() requests mismatch (-want +got):\n%s", diff)
+			}
+			if status := gcpManagedControlPlane.Status; status.FleetMembership != "" || status.FleetMembershipCreated {
+				t.Errorf("unregisterFleetMembership() left membership %q (created %v) in the status", status.FleetMembership, status.FleetMembershipCreated)
+			}
+		})
+	}
+}
+
+func TestDeleteUnownedClusterKeepsFleetMembership(t *testing.T) {
+	hub := &fakeGKEHub{}
+	cluster := newUnchangedCluster()
+	cluster.Name = "my-cluster"
+	cluster.Status = containerpb.Cluster_RUNNING
+	gcpManagedControlPlane := newFleetControlPlane(true)
+	s := New(newTestManagedControlPlaneScope(t, &fakeClusterManager{cluster: cluster}, gcpManagedControlPlane, newFakeGKEHubService(t, hub)))
+
+	if _, err := s.Delete(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if len(hub.requests) != 0 {
+		t.Errorf("Delete() sent %v to GKE Hub for a cluster left in place", hub.requests)
+	}
+	if gcpManagedControlPlane.Status.FleetMembership == "" {
+		t.Errorf("Delete() forgot the fleet membership of a cluster left in place")
+	}
+}
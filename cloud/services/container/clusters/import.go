@@ -0,0 +1,204 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"maps"
+	"sort"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ErrUnownedCluster is returned when a GKE cluster that wasn't created by Cluster API exists and import is not enabled.
+var ErrUnownedCluster = errors.New("GKE cluster exists but was not created by Cluster API, set spec.import to adopt it")
+
+// isClusterOwned returns true if the GKE cluster was created by Cluster API. Clusters created before ownership
+// labels were introduced are recognized by the creating condition, unless the control plane imports the cluster.
+func (s *Service) isClusterOwned(cluster *containerpb.Cluster) bool {
+	if scope.IsOwnedResource(cluster.GetResourceLabels(), s.scope.ClusterName()) {
+		return true
+	}
+	return !s.scope.GCPManagedControlPlane.Spec.Import && conditions.Has(s.scope.GCPManagedControlPlane, infrav1exp.GKEControlPlaneCreatingCondition)
+}
+
+// reconcileOwnership makes sure the existing GKE cluster can be managed. Imported clusters are adopted and the
+// spec populated from them, clusters created before ownership labels were introduced are labeled.
+func (s *Service) reconcileOwnership(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) error {
+	if scope.IsOwnedResource(cluster.GetResourceLabels(), s.scope.ClusterName()) {
+		return nil
+	}
+
+	switch {
+	case s.scope.GCPManagedControlPlane.Spec.Import:
+		if s.scope.GCPManagedControlPlane.Status.Imported {
+			return nil
+		}
+		if cluster.GetAutopilot().GetEnabled() != s.scope.GCPManagedControlPlane.Spec.EnableAutopilot {
+			return errors.Errorf("GKE cluster has autopilot enabled %t, which doesn't match enableAutopilot", cluster.GetAutopilot().GetEnabled())
+		}
+		populateSpecFromCluster(&s.scope.GCPManagedControlPlane.Spec, cluster)
+		s.scope.GCPManagedControlPlane.Status.Imported = true
+		log.Info("Adopted existing GKE cluster", "name", s.scope.ClusterName())
+		return nil
+	case s.isClusterOwned(cluster):
+		resourceLabels := maps.Clone(cluster.GetResourceLabels())
+		if resourceLabels == nil {
+			resourceLabels = map[string]string{}
+		}
+		maps.Copy(resourceLabels, s.scope.ClusterResourceLabels())
+		log.V(2).Info("Labeling GKE cluster created by Cluster API", "labels", resourceLabels)
+		_, err := s.scope.ManagedControlPlaneClient().SetLabels(ctx, &containerpb.SetLabelsRequest{
+			Name:             s.scope.ClusterFullName(),
+			ResourceLabels:   resourceLabels,
+			LabelFingerprint: cluster.GetLabelFingerprint(),
+		})
+		return err
+	default:
+		return ErrUnownedCluster
+	}
+}
+
+// populateSpecFromCluster sets the unset fields of the spec, which would otherwise change the GKE cluster, from
+// the live cluster.
+func populateSpecFromCluster(spec *infrav1exp.GCPManagedControlPlaneSpec, cluster *containerpb.Cluster) {
+	if spec.ReleaseChannel == nil {
+		spec.ReleaseChannel = convertFromSdkReleaseChannel(cluster.GetReleaseChannel().GetChannel())
+	}
+	if spec.MasterAuthorizedNetworksConfig == nil {
+		spec.MasterAuthorizedNetworksConfig = convertFromSdkMasterAuthorizedNetworksConfig(cluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetAuthorizedNetworksConfig())
+	}
+	if spec.DNSEndpoint == nil && cluster.GetControlPlaneEndpointsConfig().GetDnsEndpointConfig().GetAllowExternalTraffic() {
+		spec.DNSEndpoint = &infrav1exp.DNSEndpointConfig{AllowExternalTraffic: true}
+	}
+	if spec.WorkloadIdentityConfig == nil && cluster.GetWorkloadIdentityConfig().GetWorkloadPool() != "" {
+		spec.WorkloadIdentityConfig = &infrav1exp.WorkloadIdentityConfig{
+			WorkloadPool: cluster.GetWorkloadIdentityConfig().GetWorkloadPool(),
+		}
+	}
+	if spec.AuthenticatorGroupConfig == nil && cluster.GetAuthenticatorGroupsConfig().GetEnabled() {
+		spec.AuthenticatorGroupConfig = &infrav1exp.AuthenticatorGroupConfig{
+			SecurityGroups: cluster.GetAuthenticatorGroupsConfig().GetSecurityGroup(),
+		}
+	}
+	if cluster.GetNetworkConfig().GetEnableIntraNodeVisibility() {
+		if spec.ClusterNetwork == nil {
+			spec.ClusterNetwork = &infrav1exp.ClusterNetwork{}
+		}
+		spec.ClusterNetwork.EnableIntraNodeVisibility = true
+	}
+	if spec.MaintenancePolicy == nil {
+		spec.MaintenancePolicy = convertFromSdkMaintenanceWindow(cluster.GetMaintenancePolicy().GetWindow())
+	}
+	if !spec.EnableAutopilot {
+		if spec.LoggingService == nil && cluster.GetLoggingService() != "" {
+			spec.LoggingService = ptr.To(infrav1exp.LoggingService(cluster.GetLoggingService()))
+		}
+		if spec.MonitoringService == nil && cluster.GetMonitoringService() != "" {
+			spec.MonitoringService = ptr.To(infrav1exp.MonitoringService(cluster.GetMonitoringService()))
+		}
+	}
+}
+
+// convertFromSdkMaintenanceWindow converts the maintenance window of a GKE cluster to the MaintenancePolicy defined
+// in CRs. It returns nil when the cluster has neither a maintenance window nor exclusions.
+func convertFromSdkMaintenanceWindow(window *containerpb.MaintenanceWindow) *infrav1exp.MaintenancePolicy {
+	policy := &infrav1exp.MaintenancePolicy{}
+	switch {
+	case window.GetDailyMaintenanceWindow().GetStartTime() != "":
+		policy.DailyMaintenanceWindow = &infrav1exp.DailyMaintenanceWindow{
+			StartTime: window.GetDailyMaintenanceWindow().GetStartTime(),
+		}
+	case window.GetRecurringWindow() != nil:
+		recurring := window.GetRecurringWindow()
+		policy.RecurringWindow = &infrav1exp.RecurringMaintenanceWindow{
+			StartTime:  metav1.NewTime(recurring.GetWindow().GetStartTime().AsTime()),
+			EndTime:    metav1.NewTime(recurring.GetWindow().GetEndTime().AsTime()),
+			Recurrence: recurring.GetRecurrence(),
+		}
+	}
+
+	for name, exclusion := range window.GetMaintenanceExclusions() {
+		maintenanceExclusion := infrav1exp.MaintenanceExclusion{
+			Name:      name,
+			StartTime: metav1.NewTime(exclusion.GetStartTime().AsTime()),
+			EndTime:   metav1.NewTime(exclusion.GetEndTime().AsTime()),
+		}
+		if exclusion.GetMaintenanceExclusionOptions() != nil {
+			maintenanceExclusion.Scope = ptr.To(convertFromSdkMaintenanceExclusionScope(exclusion.GetMaintenanceExclusionOptions().GetScope()))
+		}
+		policy.MaintenanceExclusions = append(policy.MaintenanceExclusions, maintenanceExclusion)
+	}
+	sort.Slice(policy.MaintenanceExclusions, func(i, j int) bool {
+		return policy.MaintenanceExclusions[i].Name < policy.MaintenanceExclusions[j].Name
+	})
+
+	if policy.DailyMaintenanceWindow == nil && policy.RecurringWindow == nil && len(policy.MaintenanceExclusions) == 0 {
+		return nil
+	}
+	return policy
+}
+
+func convertFromSdkMaintenanceExclusionScope(scope containerpb.MaintenanceExclusionOptions_Scope) infrav1exp.MaintenanceExclusionScope {
+	switch scope {
+	case containerpb.MaintenanceExclusionOptions_NO_MINOR_UPGRADES:
+		return infrav1exp.NoMinorUpgrades
+	case containerpb.MaintenanceExclusionOptions_NO_MINOR_OR_NODE_UPGRADES:
+		return infrav1exp.NoMinorOrNodeUpgrades
+	default:
+		return infrav1exp.NoUpgrades
+	}
+}
+
+func convertFromSdkReleaseChannel(channel containerpb.ReleaseChannel_Channel) *infrav1exp.ReleaseChannel {
+	switch channel {
+	case containerpb.ReleaseChannel_RAPID:
+		return ptr.To(infrav1exp.Rapid)
+	case containerpb.ReleaseChannel_REGULAR:
+		return ptr.To(infrav1exp.Regular)
+	case containerpb.ReleaseChannel_STABLE:
+		return ptr.To(infrav1exp.Stable)
+	default:
+		return nil
+	}
+}
+
+func convertFromSdkMasterAuthorizedNetworksConfig(config *containerpb.MasterAuthorizedNetworksConfig) *infrav1exp.MasterAuthorizedNetworksConfig {
+	if !config.GetEnabled() {
+		return nil
+	}
+
+	cidrBlocks := make([]*infrav1exp.MasterAuthorizedNetworksConfigCidrBlock, len(config.GetCidrBlocks()))
+	for i, cidrBlock := range config.GetCidrBlocks() {
+		cidrBlocks[i] = &infrav1exp.MasterAuthorizedNetworksConfigCidrBlock{
+			CidrBlock:   cidrBlock.GetCidrBlock(),
+			DisplayName: cidrBlock.GetDisplayName(),
+		}
+	}
+
+	return &infrav1exp.MasterAuthorizedNetworksConfig{
+		CidrBlocks:                  cidrBlocks,
+		GcpPublicCidrsAccessEnabled: config.GcpPublicCidrsAccessEnabled,
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestPopulateSpecFromCluster(t *testing.T) {
+	cluster := &containerpb.Cluster{
+		ReleaseChannel: &containerpb.ReleaseChannel{
+			Channel: containerpb.ReleaseChannel_STABLE,
+		},
+		ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+					Enabled: true,
+					CidrBlocks: []*containerpb.MasterAuthorizedNetworksConfig_CidrBlock{
+						{CidrBlock: "10.0.0.0/8", DisplayName: "internal"},
+					},
+				},
+			},
+			DnsEndpointConfig: &containerpb.ControlPlaneEndpointsConfig_DNSEndpointConfig{
+				AllowExternalTraffic: ptr.To(true),
+			},
+		},
+		WorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{
+			WorkloadPool: "my-project.svc.id.goog",
+		},
+		LoggingService:    "logging.googleapis.com/kubernetes",
+		MonitoringService: "none",
+	}
+
+	tests := []struct {
+		name string
+		spec infrav1exp.GCPManagedControlPlaneSpec
+		want infrav1exp.GCPManagedControlPlaneSpec
+	}{
+		{
+			name: "empty spec",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{},
+			want: infrav1exp.GCPManagedControlPlaneSpec{
+				ReleaseChannel: ptr.To(infrav1exp.Stable),
+				MasterAuthorizedNetworksConfig: &infrav1exp.MasterAuthorizedNetworksConfig{
+					CidrBlocks: []*infrav1exp.MasterAuthorizedNetworksConfigCidrBlock{
+						{CidrBlock: "10.0.0.0/8", DisplayName: "internal"},
+					},
+				},
+				DNSEndpoint: &infrav1exp.DNSEndpointConfig{AllowExternalTraffic: true},
+				WorkloadIdentityConfig: &infrav1exp.WorkloadIdentityConfig{
+					WorkloadPool: "my-project.svc.id.goog",
+				},
+				LoggingService:    ptr.To(infrav1exp.LoggingService("logging.googleapis.com/kubernetes")),
+				MonitoringService: ptr.To(infrav1exp.MonitoringService("none")),
+			},
+		},
+		{
+			name: "set fields are kept and autopilot services are not populated",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				EnableAutopilot: true,
+				ReleaseChannel:  ptr.To(infrav1exp.Rapid),
+			},
+			want: infrav1exp.GCPManagedControlPlaneSpec{
+				EnableAutopilot: true,
+				ReleaseChannel:  ptr.To(infrav1exp.Rapid),
+				MasterAuthorizedNetworksConfig: &infrav1exp.MasterAuthorizedNetworksConfig{
+					CidrBlocks: []*infrav1exp.MasterAuthorizedNetworksConfigCidrBlock{
+						{CidrBlock: "10.0.0.0/8", DisplayName: "internal"},
+					},
+				},
+				DNSEndpoint: &infrav1exp.DNSEndpointConfig{AllowExternalTraffic: true},
+				WorkloadIdentityConfig: &infrav1exp.WorkloadIdentityConfig{
+					WorkloadPool: "my-project.svc.id.goog",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			populateSpecFromCluster(&spec, cluster)
+			if diff := cmp.Diff(tt.want, spec); diff != "" {
+				t.Errorf("populateSpecFromCluster() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPopulateSpecFromClusterNeedsNoUpdate(t *testing.T) {
+	cluster := &containerpb.Cluster{
+		ReleaseChannel: &containerpb.ReleaseChannel{
+			Channel: containerpb.ReleaseChannel_REGULAR,
+		},
+		NetworkConfig: &containerpb.NetworkConfig{
+			EnableIntraNodeVisibility: true,
+		},
+		MaintenancePolicy: &containerpb.MaintenancePolicy{
+			Window: &containerpb.MaintenanceWindow{
+				Policy: &containerpb.MaintenanceWindow_DailyMaintenanceWindow{
+					DailyMaintenanceWindow: &containerpb.DailyMaintenanceWindow{StartTime: "03:00", Duration: "PT4H0M0S"},
+				},
+				MaintenanceExclusions: map[string]*containerpb.TimeWindow{
+					"holidays": {
+						StartTime: timestamppb.New(time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)),
+						EndTime:   timestamppb.New(time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)),
+						Options: &containerpb.TimeWindow_MaintenanceExclusionOptions{
+							MaintenanceExclusionOptions: &containerpb.MaintenanceExclusionOptions{
+								Scope: containerpb.MaintenanceExclusionOptions_NO_MINOR_UPGRADES,
+							},
+						},
+					},
+				},
+			},
+		},
+		WorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{
+			WorkloadPool: "my-project.svc.id.goog",
+		},
+		AuthenticatorGroupsConfig: &containerpb.AuthenticatorGroupsConfig{
+			Enabled:       true,
+			SecurityGroup: "gke-security-groups@example.com",
+		},
+		LoggingService:    "logging.googleapis.com/kubernetes",
+		MonitoringService: "monitoring.googleapis.com/kubernetes",
+	}
+
+	s := New(&scope.ManagedControlPlaneScope{
+		GCPManagedControlPlane: &infrav1exp.GCPManagedControlPlane{},
+	})
+	populateSpecFromCluster(&s.scope.GCPManagedControlPlane.Spec, cluster)
+
+	log := logr.Discard()
+	if needUpdate, request := s.checkDiffAndPrepareUpdate(cluster, false, &log); needUpdate {
+		t.Errorf("checkDiffAndPrepareUpdate() of an imported cluster needs update %v", request.GetUpdate())
+	}
+	if needUpdate, request := s.checkDiffAndPrepareMaintenancePolicy(cluster, &log); needUpdate {
+		t.Errorf("checkDiffAndPrepareMaintenancePolicy() of an imported cluster needs update %v", request.GetMaintenancePolicy())
+	}
+}
@@ -17,9 +17,10 @@ limitations under the License.
 package clusters
 
 import (
+	"bytes"
 	"context"
-	"encoding/base64"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/container/apiv1/containerpb"
 	"cloud.google.com/go/iam/credentials/apiv1/credentialspb"
@@ -39,169 +40,211 @@ import (
 const (
 	// GkeScope is the scope to request when generating access token.
 	GkeScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	// kubeconfigTokenExpiryAnnotation records when the token embedded in a kubeconfig secret expires.
+	kubeconfigTokenExpiryAnnotation = "gcpmanagedcontrolplane.infrastructure.cluster.x-k8s.io/token-expiry"
+	// kubeconfigAuthModeAnnotation records the authentication mode of a kubeconfig secret.
+	kubeconfigAuthModeAnnotation = "gcpmanagedcontrolplane.infrastructure.cluster.x-k8s.io/auth-mode"
+	// kubeconfigRefreshBefore is how long before its token expires a kubeconfig secret is refreshed.
+	kubeconfigRefreshBefore = 10 * time.Minute
 )
 
-func (s *Service) reconcileKubeconfig(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) error {
+// reconcileKubeconfig reconciles the kubeconfig secret used by Cluster API. It returns how long until the
+// secret needs to be refreshed.
+func (s *Service) reconcileKubeconfig(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) (time.Duration, error) {
 	log.Info("Reconciling kubeconfig")
 	clusterRef := types.NamespacedName{
 		Name:      s.scope.Cluster.Name,
 		Namespace: s.scope.Cluster.Namespace,
 	}
 
-	configSecret, err := secret.GetFromNamespacedName(ctx, s.scope.Client(), clusterRef, secret.Kubeconfig)
-	if err != nil {
-		if !apierrors.IsNotFound(err) {
-			log.Error(err, "getting kubeconfig secret", "name", clusterRef)
-			return fmt.Errorf("getting kubeconfig secret %s: %w", clusterRef, err)
-		}
-		log.Info("kubeconfig secret not found, creating")
-
-		if createErr := s.createCAPIKubeconfigSecret(
-			ctx,
-			cluster,
-			&clusterRef,
-			log,
-		); createErr != nil {
-			return fmt.Errorf("creating kubeconfig secret: %w", createErr)
-		}
-	} else if updateErr := s.updateCAPIKubeconfigSecret(ctx, configSecret); updateErr != nil {
-		return fmt.Errorf("updating kubeconfig secret: %w", err)
-	}
-
-	return nil
+	return s.reconcileKubeconfigSecret(ctx, cluster, clusterRef, s.capiKubeconfigAuthMode(), log)
 }
 
-func (s *Service) reconcileAdditionalKubeconfigs(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) error {
+// reconcileAdditionalKubeconfigs reconciles the kubeconfig secret for users. It returns how long until the
+// secret needs to be refreshed, zero if it never needs refreshing.
+func (s *Service) reconcileAdditionalKubeconfigs(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) (time.Duration, error) {
 	log.Info("Reconciling additional kubeconfig")
 	clusterRef := types.NamespacedName{
 		Name:      s.scope.Cluster.Name + "-user",
 		Namespace: s.scope.Cluster.Namespace,
 	}
 
-	// Create the additional kubeconfig for users. This doesn't need updating on every sync
-	_, err := secret.GetFromNamespacedName(ctx, s.scope.Client(), clusterRef, secret.Kubeconfig)
-	if err != nil {
-		if !apierrors.IsNotFound(err) {
-			return fmt.Errorf("getting kubeconfig (user) secret %s: %w", clusterRef, err)
-		}
+	return s.reconcileKubeconfigSecret(ctx, cluster, clusterRef, s.userKubeconfigAuthMode(), log)
+}
 
-		createErr := s.createUserKubeconfigSecret(
-			ctx,
-			cluster,
-			&clusterRef,
-		)
-		if createErr != nil {
-			return fmt.Errorf("creating additional kubeconfig secret: %w", err)
-		}
+func (s *Service) capiKubeconfigAuthMode() infrav1exp.KubeconfigAuthMode {
+	if cfg := s.scope.GCPManagedControlPlane.Spec.Kubeconfig; cfg != nil && cfg.CAPIAuthMode != "" {
+		return cfg.CAPIAuthMode
 	}
+	return infrav1exp.KubeconfigTokenRefresh
+}
 
-	return nil
+func (s *Service) userKubeconfigAuthMode() infrav1exp.KubeconfigAuthMode {
+	if cfg := s.scope.GCPManagedControlPlane.Spec.Kubeconfig; cfg != nil && cfg.UserAuthMode != "" {
+		return cfg.UserAuthMode
+	}
+	return infrav1exp.KubeconfigExecPlugin
 }
 
-func (s *Service) createUserKubeconfigSecret(ctx context.Context, cluster *containerpb.Cluster, clusterRef *types.NamespacedName) error {
-	controllerOwnerRef := *metav1.NewControllerRef(s.scope.GCPManagedControlPlane, infrav1exp.GroupVersion.WithKind("GCPManagedControlPlane"))
+func (s *Service) serviceAccountTokenTTL() time.Duration {
+	if cfg := s.scope.GCPManagedControlPlane.Spec.Kubeconfig; cfg != nil && cfg.ServiceAccountTokenTTL != nil {
+		return cfg.ServiceAccountTokenTTL.Duration
+	}
+	return infrav1exp.DefaultServiceAccountTokenTTL
+}
 
+// reconcileKubeconfigSecret creates the kubeconfig secret clusterRef, or rewrites it when its auth mode or
+// cluster endpoint changed or its token is about to expire.
+func (s *Service) reconcileKubeconfigSecret(ctx context.Context, cluster *containerpb.Cluster, clusterRef types.NamespacedName, mode infrav1exp.KubeconfigAuthMode, log *logr.Logger) (time.Duration, error) {
 	contextName := s.getKubeConfigContextName(false)
-
 	cfg, err := s.createBaseKubeConfig(contextName, cluster)
 	if err != nil {
-		return fmt.Errorf("creating base kubeconfig: %w", err)
+		return 0, fmt.Errorf("creating base kubeconfig: %w", err)
 	}
 
-	execConfig := &api.ExecConfig{
-		APIVersion:         "client.authentication.k8s.io/v1beta1",
-		Command:            "gke-gcloud-auth-plugin",
-		InstallHint:        "Install gke-gcloud-auth-plugin for use with kubectl by following\n		https://cloud.google.com/blog/products/containers-kubernetes/kubectl-auth-changes-in-gke",
-		ProvideClusterInfo: true,
+	configSecret, err := secret.GetFromNamespacedName(ctx, s.scope.Client(), clusterRef, secret.Kubeconfig)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "getting kubeconfig secret", "name", clusterRef)
+			return 0, fmt.Errorf("getting kubeconfig secret %s: %w", clusterRef, err)
+		}
+		configSecret = nil
+		log.Info("kubeconfig secret not found, creating", "name", clusterRef)
+	} else if !kubeconfigNeedsRefresh(configSecret, cfg, contextName, mode, time.Now()) {
+		return kubeconfigRefreshAfter(configSecret, time.Now()), nil
+	}
+
+	authInfo, expiry, err := s.kubeconfigAuthInfo(ctx, cluster, mode)
+	if err != nil {
+		log.Error(err, "failed generating kubeconfig credentials", "mode", mode)
+		return 0, err
 	}
 	cfg.AuthInfos = map[string]*api.AuthInfo{
-		contextName: {
-			Exec: execConfig,
-		},
+		contextName: authInfo,
 	}
 
 	out, err := clientcmd.Write(*cfg)
 	if err != nil {
-		return fmt.Errorf("serialize kubeconfig to yaml: %w", err)
+		return 0, fmt.Errorf("serialize kubeconfig to yaml: %w", err)
 	}
 
-	kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(*clusterRef, out, controllerOwnerRef)
-	if err := s.scope.Client().Create(ctx, kubeconfigSecret); err != nil {
-		return fmt.Errorf("creating secret: %w", err)
+	if configSecret == nil {
+		controllerOwnerRef := *metav1.NewControllerRef(s.scope.GCPManagedControlPlane, infrav1exp.GroupVersion.WithKind("GCPManagedControlPlane"))
+		configSecret = kubeconfig.GenerateSecretWithOwner(clusterRef, out, controllerOwnerRef)
+		setKubeconfigAnnotations(configSecret, mode, expiry)
+		if err := s.scope.Client().Create(ctx, configSecret); err != nil {
+			return 0, fmt.Errorf("creating kubeconfig secret: %w", err)
+		}
+	} else {
+		configSecret.Data[secret.KubeconfigDataName] = out
+		setKubeconfigAnnotations(configSecret, mode, expiry)
+		if err := s.scope.Client().Update(ctx, configSecret); err != nil {
+			return 0, fmt.Errorf("updating kubeconfig secret: %w", err)
+		}
 	}
 
-	return nil
+	return kubeconfigRefreshAfter(configSecret, time.Now()), nil
 }
 
-func (s *Service) createCAPIKubeconfigSecret(ctx context.Context, cluster *containerpb.Cluster, clusterRef *types.NamespacedName, log *logr.Logger) error {
-	controllerOwnerRef := *metav1.NewControllerRef(s.scope.GCPManagedControlPlane, infrav1exp.GroupVersion.WithKind("GCPManagedControlPlane"))
-
-	contextName := s.getKubeConfigContextName(false)
-
-	cfg, err := s.createBaseKubeConfig(contextName, cluster)
-	if err != nil {
-		log.Error(err, "failed creating base config")
-		return fmt.Errorf("creating base kubeconfig: %w", err)
+// kubeconfigAuthInfo returns the credentials for a kubeconfig using mode and when they expire, zero if they
+// don't expire.
+func (s *Service) kubeconfigAuthInfo(ctx context.Context, cluster *containerpb.Cluster, mode infrav1exp.KubeconfigAuthMode) (*api.AuthInfo, time.Time, error) {
+	switch mode {
+	case infrav1exp.KubeconfigExecPlugin:
+		return &api.AuthInfo{
+			Exec: &api.ExecConfig{
+				APIVersion:         "client.authentication.k8s.io/v1beta1",
+				Command:            "gke-gcloud-auth-plugin",
+				InstallHint:        "Install gke-gcloud-auth-plugin for use with kubectl by following\n		https://cloud.google.com/blog/products/containers-kubernetes/kubectl-auth-changes-in-gke",
+				ProvideClusterInfo: true,
+			},
+		}, time.Time{}, nil
+	case infrav1exp.KubeconfigTokenRefresh:
+		token, expiry, err := s.generateToken(ctx)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &api.AuthInfo{Token: token}, expiry, nil
+	case infrav1exp.KubeconfigServiceAccountToken:
+		token, expiry, err := s.generateServiceAccountToken(ctx, cluster)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return &api.AuthInfo{Token: token}, expiry, nil
+	default:
+		return nil, time.Time{}, errors.Errorf("unsupported kubeconfig auth mode %q", mode)
 	}
+}
 
-	token, err := s.generateToken(ctx)
-	if err != nil {
-		log.Error(err, "failed generating token")
-		return err
-	}
-	cfg.AuthInfos = map[string]*api.AuthInfo{
-		contextName: {
-			Token: token,
-		},
+// kubeconfigNeedsRefresh returns true if configSecret doesn't match the desired auth mode and cluster, or its
+// token expires within kubeconfigRefreshBefore of now.
+func kubeconfigNeedsRefresh(configSecret *corev1.Secret, desired *api.Config, contextName string, mode infrav1exp.KubeconfigAuthMode, now time.Time) bool {
+	annotations := configSecret.GetAnnotations()
+	if annotations[kubeconfigAuthModeAnnotation] != string(mode) {
+		// Secrets created before auth modes existed are kept as they are for the user kubeconfig, which used
+		// the exec plugin.
+		if annotations[kubeconfigAuthModeAnnotation] != "" || mode != infrav1exp.KubeconfigExecPlugin {
+			return true
+		}
 	}
 
-	out, err := clientcmd.Write(*cfg)
+	data, ok := configSecret.Data[secret.KubeconfigDataName]
+	if !ok {
+		return true
+	}
+	current, err := clientcmd.Load(data)
 	if err != nil {
-		log.Error(err, "failed serializing kubeconfig to yaml")
-		return fmt.Errorf("serialize kubeconfig to yaml: %w", err)
+		return true
 	}
-
-	kubeconfigSecret := kubeconfig.GenerateSecretWithOwner(*clusterRef, out, controllerOwnerRef)
-	if err := s.scope.Client().Create(ctx, kubeconfigSecret); err != nil {
-		log.Error(err, "failed creating secret")
-		return fmt.Errorf("creating secret: %w", err)
+	currentCluster, desiredCluster := current.Clusters[contextName], desired.Clusters[contextName]
+	if currentCluster == nil || currentCluster.Server != desiredCluster.Server ||
+		!bytes.Equal(currentCluster.CertificateAuthorityData, desiredCluster.CertificateAuthorityData) {
+		return true
 	}
 
-	return nil
+	if mode == infrav1exp.KubeconfigExecPlugin {
+		return false
+	}
+	expiry, ok := kubeconfigTokenExpiry(configSecret)
+	return !ok || !now.Before(expiry.Add(-kubeconfigRefreshBefore))
 }
 
-func (s *Service) updateCAPIKubeconfigSecret(ctx context.Context, configSecret *corev1.Secret) error {
-	data, ok := configSecret.Data[secret.KubeconfigDataName]
+// kubeconfigRefreshAfter returns how long after now configSecret needs to be refreshed, zero if its token
+// doesn't expire.
+func kubeconfigRefreshAfter(configSecret *corev1.Secret, now time.Time) time.Duration {
+	expiry, ok := kubeconfigTokenExpiry(configSecret)
 	if !ok {
-		return errors.Errorf("missing key %q in secret data", secret.KubeconfigDataName)
+		return 0
 	}
-
-	config, err := clientcmd.Load(data)
-	if err != nil {
-		return errors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
+	if after := expiry.Add(-kubeconfigRefreshBefore).Sub(now); after > time.Second {
+		return after
 	}
+	return time.Second
+}
 
-	token, err := s.generateToken(ctx)
-	if err != nil {
-		return err
+func kubeconfigTokenExpiry(configSecret *corev1.Secret) (time.Time, bool) {
+	value, ok := configSecret.GetAnnotations()[kubeconfigTokenExpiryAnnotation]
+	if !ok {
+		return time.Time{}, false
 	}
-
-	contextName := s.getKubeConfigContextName(false)
-	config.AuthInfos[contextName].Token = token
-
-	out, err := clientcmd.Write(*config)
+	expiry, err := time.Parse(time.RFC3339, value)
 	if err != nil {
-		return errors.Wrap(err, "failed to serialize config to yaml")
+		return time.Time{}, false
 	}
+	return expiry, true
+}
 
-	configSecret.Data[secret.KubeconfigDataName] = out
-
-	err = s.scope.Client().Update(ctx, configSecret)
-	if err != nil {
-		return fmt.Errorf("updating kubeconfig secret: %w", err)
+func setKubeconfigAnnotations(configSecret *corev1.Secret, mode infrav1exp.KubeconfigAuthMode, expiry time.Time) {
+	if configSecret.Annotations == nil {
+		configSecret.Annotations = map[string]string{}
+	}
+	configSecret.Annotations[kubeconfigAuthModeAnnotation] = string(mode)
+	if expiry.IsZero() {
+		delete(configSecret.Annotations, kubeconfigTokenExpiryAnnotation)
+	} else {
+		configSecret.Annotations[kubeconfigTokenExpiryAnnotation] = expiry.UTC().Format(time.RFC3339)
 	}
-
-	return nil
 }
 
 func (s *Service) getKubeConfigContextName(isUser bool) string {
@@ -213,15 +256,18 @@ func (s *Service) getKubeConfigContextName(isUser bool) string {
 }
 
 func (s *Service) createBaseKubeConfig(contextName string, cluster *containerpb.Cluster) (*api.Config, error) {
-	certData, err := base64.StdEncoding.DecodeString(cluster.GetMasterAuth().GetClusterCaCertificate())
+	host, certData, err := controlPlaneEndpoint(cluster, s.scope.GCPManagedControlPlane.Spec.EndpointType)
 	if err != nil {
-		return nil, fmt.Errorf("decoding cluster CA cert: %w", err)
+		return nil, err
+	}
+	if host == "" {
+		return nil, errors.New("control plane endpoint is not available yet")
 	}
 	cfg := &api.Config{
 		APIVersion: api.SchemeGroupVersion.Version,
 		Clusters: map[string]*api.Cluster{
 			contextName: {
-				Server:                   "https://" + cluster.GetEndpoint(),
+				Server:                   "https://" + host,
 				CertificateAuthorityData: certData,
 			},
 		},
@@ -237,7 +283,8 @@ func (s *Service) createBaseKubeConfig(contextName string, cluster *containerpb.
 	return cfg, nil
 }
 
-func (s *Service) generateToken(ctx context.Context) (string, error) {
+// generateToken mints an access token for the controller service account and returns it with its expiry.
+func (s *Service) generateToken(ctx context.Context) (string, time.Time, error) {
 	req := &credentialspb.GenerateAccessTokenRequest{
 		Name: "projects/-/serviceAccounts/" + s.scope.GetCredential().ClientEmail,
 		Scope: []string{
@@ -246,8 +293,13 @@ func (s *Service) generateToken(ctx context.Context) (string, error) {
 	}
 	resp, err := s.scope.CredentialsClient().GenerateAccessToken(ctx, req)
 	if err != nil {
-		return "", errors.Errorf("error generating access token: %v", err)
+		return "", time.Time{}, errors.Errorf("error generating access token: %v", err)
+	}
+
+	var expiry time.Time
+	if resp.GetExpireTime() != nil {
+		expiry = resp.GetExpireTime().AsTime()
 	}
 
-	return resp.GetAccessToken(), nil
+	return resp.GetAccessToken(), expiry, nil
 }
@@ -0,0 +1,158 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestKubeconfigNeedsRefresh(t *testing.T) {
+	const contextName = "gke_project_location_cluster"
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newConfig := func(server string) *api.Config {
+		return &api.Config{
+			Clusters: map[string]*api.Cluster{
+				contextName: {
+					Server:                   server,
+					CertificateAuthorityData: []byte("ca"),
+				},
+			},
+			AuthInfos: map[string]*api.AuthInfo{
+				contextName: {Token: "token"},
+			},
+		}
+	}
+	newSecret := func(server string, annotations map[string]string) *corev1.Secret {
+		data, err := clientcmd.Write(*newConfig(server))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Data:       map[string][]byte{secret.KubeconfigDataName: data},
+		}
+	}
+	tokenAnnotations := func(mode infrav1exp.KubeconfigAuthMode, expiry time.Time) map[string]string {
+		return map[string]string{
+			kubeconfigAuthModeAnnotation:    string(mode),
+			kubeconfigTokenExpiryAnnotation: expiry.Format(time.RFC3339),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		secret *corev1.Secret
+		server string
+		mode   infrav1exp.KubeconfigAuthMode
+		want   bool
+	}{
+		{
+			name:   "token valid beyond the refresh window",
+			secret: newSecret("https://1.2.3.4", tokenAnnotations(infrav1exp.KubeconfigTokenRefresh, now.Add(30*time.Minute))),
+			server: "https://1.2.3.4",
+			mode:   infrav1exp.KubeconfigTokenRefresh,
+			want:   false,
+		},
+		{
+			name:   "token expiring within the refresh window",
+			secret: newSecret("https://1.2.3.4", tokenAnnotations(infrav1exp.KubeconfigTokenRefresh, now.Add(5*time.Minute))),
+			server: "https://1.2.3.4",
+			mode:   infrav1exp.KubeconfigTokenRefresh,
+			want:   true,
+		},
+		{
+			name:   "token without expiry",
+			secret: newSecret("https://1.2.3.4", nil),
+			server: "https://1.2.3.4",
+			mode:   infrav1exp.KubeconfigTokenRefresh,
+			want:   true,
+		},
+		{
+			name:   "auth mode changed",
+			secret: newSecret("https://1.2.3.4", tokenAnnotations(infrav1exp.KubeconfigTokenRefresh, now.Add(30*time.Minute))),
+			server: "https://1.2.3.4",
+			mode:   infrav1exp.KubeconfigServiceAccountToken,
+			want:   true,
+		},
+		{
+			name:   "endpoint changed",
+			secret: newSecret("https://1.2.3.4", tokenAnnotations(infrav1exp.KubeconfigTokenRefresh, now.Add(30*time.Minute))),
+			server: "https://5.6.7.8",
+			mode:   infrav1exp.KubeconfigTokenRefresh,
+			want:   true,
+		},
+		{
+			name:   "exec plugin secret created before auth modes",
+			secret: newSecret("https://1.2.3.4", nil),
+			server: "https://1.2.3.4",
+			mode:   infrav1exp.KubeconfigExecPlugin,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kubeconfigNeedsRefresh(tt.secret, newConfig(tt.server), contextName, tt.mode, now); got != tt.want {
+				t.Errorf("kubeconfigNeedsRefresh() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKubeconfigRefreshAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		expiry time.Time
+		want   time.Duration
+	}{
+		{
+			name: "no expiry",
+			want: 0,
+		},
+		{
+			name:   "expiry in an hour",
+			expiry: now.Add(time.Hour),
+			want:   50 * time.Minute,
+		},
+		{
+			name:   "expiry within the refresh window",
+			expiry: now.Add(time.Minute),
+			want:   time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configSecret := &corev1.Secret{}
+			setKubeconfigAnnotations(configSecret, infrav1exp.KubeconfigTokenRefresh, tt.expiry)
+			if got := kubeconfigRefreshAfter(configSecret, now); got != tt.want {
+				t.Errorf("kubeconfigRefreshAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
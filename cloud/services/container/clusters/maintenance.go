@@ -0,0 +1,306 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+const (
+	// dailyMaintenanceWindowDuration is the fixed duration GKE uses for daily maintenance windows.
+	dailyMaintenanceWindowDuration = 4 * time.Hour
+	// maxMaintenanceLookaheadDays bounds the search for the next maintenance window.
+	maxMaintenanceLookaheadDays = 366
+)
+
+// convertToSdkMaintenancePolicy converts the MaintenancePolicy defined in CRs to the SDK version.
+func convertToSdkMaintenancePolicy(policy *infrav1exp.MaintenancePolicy) *containerpb.MaintenancePolicy {
+	// if policy is nil, it means that the user wants no maintenance policy.
+	if policy == nil {
+		return &containerpb.MaintenancePolicy{}
+	}
+
+	window := &containerpb.MaintenanceWindow{}
+	switch {
+	case policy.DailyMaintenanceWindow != nil:
+		window.Policy = &containerpb.MaintenanceWindow_DailyMaintenanceWindow{
+			DailyMaintenanceWindow: &containerpb.DailyMaintenanceWindow{
+				StartTime: policy.DailyMaintenanceWindow.StartTime,
+			},
+		}
+	case policy.RecurringWindow != nil:
+		window.Policy = &containerpb.MaintenanceWindow_RecurringWindow{
+			RecurringWindow: &containerpb.RecurringTimeWindow{
+				Window: &containerpb.TimeWindow{
+					StartTime: timestamppb.New(policy.RecurringWindow.StartTime.Time),
+					EndTime:   timestamppb.New(policy.RecurringWindow.EndTime.Time),
+				},
+				Recurrence: policy.RecurringWindow.Recurrence,
+			},
+		}
+	}
+
+	if len(policy.MaintenanceExclusions) > 0 {
+		window.MaintenanceExclusions = make(map[string]*containerpb.TimeWindow, len(policy.MaintenanceExclusions))
+		for _, exclusion := range policy.MaintenanceExclusions {
+			timeWindow := &containerpb.TimeWindow{
+				StartTime: timestamppb.New(exclusion.StartTime.Time),
+				EndTime:   timestamppb.New(exclusion.EndTime.Time),
+			}
+			if exclusion.Scope != nil {
+				timeWindow.Options = &containerpb.TimeWindow_MaintenanceExclusionOptions{
+					MaintenanceExclusionOptions: &containerpb.MaintenanceExclusionOptions{
+						Scope: convertToSdkMaintenanceExclusionScope(*exclusion.Scope),
+					},
+				}
+			}
+			window.MaintenanceExclusions[exclusion.Name] = timeWindow
+		}
+	}
+
+	return &containerpb.MaintenancePolicy{
+		Window: window,
+	}
+}
+
+func convertToSdkMaintenanceExclusionScope(scope infrav1exp.MaintenanceExclusionScope) containerpb.MaintenanceExclusionOptions_Scope {
+	switch scope {
+	case infrav1exp.NoMinorUpgrades:
+		return containerpb.MaintenanceExclusionOptions_NO_MINOR_UPGRADES
+	case infrav1exp.NoMinorOrNodeUpgrades:
+		return containerpb.MaintenanceExclusionOptions_NO_MINOR_OR_NODE_UPGRADES
+	default:
+		return containerpb.MaintenanceExclusionOptions_NO_UPGRADES
+	}
+}
+
+// compareMaintenancePolicy returns true if the two policies have the same windows and exclusions.
+// Output only fields, such as the duration of daily windows and the resource version, are ignored.
+func compareMaintenancePolicy(a, b *containerpb.MaintenancePolicy) bool {
+	aWindow, bWindow := a.GetWindow(), b.GetWindow()
+
+	if aWindow.GetDailyMaintenanceWindow().GetStartTime() != bWindow.GetDailyMaintenanceWindow().GetStartTime() {
+		return false
+	}
+
+	aRecurring, bRecurring := aWindow.GetRecurringWindow(), bWindow.GetRecurringWindow()
+	if aRecurring.GetRecurrence() != bRecurring.GetRecurrence() ||
+		!compareTimeWindow(aRecurring.GetWindow(), bRecurring.GetWindow()) {
+		return false
+	}
+
+	aExclusions, bExclusions := aWindow.GetMaintenanceExclusions(), bWindow.GetMaintenanceExclusions()
+	if len(aExclusions) != len(bExclusions) {
+		return false
+	}
+	for name, aExclusion := range aExclusions {
+		bExclusion, ok := bExclusions[name]
+		if !ok || !compareTimeWindow(aExclusion, bExclusion) {
+			return false
+		}
+		if maintenanceExclusionScope(aExclusion) != maintenanceExclusionScope(bExclusion) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func compareTimeWindow(a, b *containerpb.TimeWindow) bool {
+	return a.GetStartTime().AsTime().Equal(b.GetStartTime().AsTime()) &&
+		a.GetEndTime().AsTime().Equal(b.GetEndTime().AsTime())
+}
+
+// maintenanceExclusionScope returns the scope of the exclusion, GKE treats exclusions without options as NO_UPGRADES.
+func maintenanceExclusionScope(exclusion *containerpb.TimeWindow) containerpb.MaintenanceExclusionOptions_Scope {
+	if exclusion.GetMaintenanceExclusionOptions() == nil {
+		return containerpb.MaintenanceExclusionOptions_NO_UPGRADES
+	}
+	return exclusion.GetMaintenanceExclusionOptions().GetScope()
+}
+
+func (s *Service) checkDiffAndPrepareMaintenancePolicy(existingCluster *containerpb.Cluster, log *logr.Logger) (bool, *containerpb.SetMaintenancePolicyRequest) {
+	desiredMaintenancePolicy := convertToSdkMaintenancePolicy(s.scope.GCPManagedControlPlane.Spec.MaintenancePolicy)
+	if compareMaintenancePolicy(desiredMaintenancePolicy, existingCluster.GetMaintenancePolicy()) {
+		return false, nil
+	}
+	log.V(2).Info("Maintenance policy update required", "current", existingCluster.GetMaintenancePolicy(), "desired", desiredMaintenancePolicy)
+
+	// The resource version of the live policy guards against concurrent updates.
+	desiredMaintenancePolicy.ResourceVersion = existingCluster.GetMaintenancePolicy().GetResourceVersion()
+
+	return true, &containerpb.SetMaintenancePolicyRequest{
+		Name:              s.scope.ClusterFullName(),
+		MaintenancePolicy: desiredMaintenancePolicy,
+	}
+}
+
+func (s *Service) setMaintenancePolicy(ctx context.Context, setMaintenancePolicyRequest *containerpb.SetMaintenancePolicyRequest, log *logr.Logger) error {
+	operation, err := s.scope.ManagedControlPlaneClient().SetMaintenancePolicy(ctx, setMaintenancePolicyRequest)
+	if err != nil {
+		log.Error(err, "Error setting GKE cluster maintenance policy", "name", s.scope.ClusterName())
+		return err
+	}
+	s.setOperation(operation)
+
+	return nil
+}
+
+// convertFromSdkMaintenancePolicy returns the status of the maintenance policy in effect at the given time.
+func convertFromSdkMaintenancePolicy(policy *containerpb.MaintenancePolicy, now time.Time) *infrav1exp.MaintenancePolicyStatus {
+	window := policy.GetWindow()
+	if window == nil {
+		return nil
+	}
+
+	status := &infrav1exp.MaintenancePolicyStatus{}
+	switch {
+	case window.GetDailyMaintenanceWindow() != nil:
+		status.Window = fmt.Sprintf("daily at %s GMT", window.GetDailyMaintenanceWindow().GetStartTime())
+	case window.GetRecurringWindow() != nil:
+		recurring := window.GetRecurringWindow()
+		status.Window = fmt.Sprintf("%s to %s, %s",
+			recurring.GetWindow().GetStartTime().AsTime().Format(time.RFC3339),
+			recurring.GetWindow().GetEndTime().AsTime().Format(time.RFC3339),
+			recurring.GetRecurrence())
+	}
+
+	for name, exclusion := range window.GetMaintenanceExclusions() {
+		if isInTimeWindow(exclusion, now) {
+			status.ActiveExclusions = append(status.ActiveExclusions, name)
+		}
+	}
+	sort.Strings(status.ActiveExclusions)
+
+	if next, ok := nextMaintenanceTime(window, now); ok {
+		status.NextMaintenanceTime = &metav1.Time{Time: next}
+	}
+
+	return status
+}
+
+// nextMaintenanceTime returns the start of the first maintenance window that has not ended at the given time
+// and is not blocked by a NO_UPGRADES exclusion. It returns false if the window recurrence is not supported.
+func nextMaintenanceTime(window *containerpb.MaintenanceWindow, now time.Time) (time.Time, bool) {
+	first, duration, weekdays, ok := maintenanceWindowSchedule(window)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	now = now.UTC()
+	// Start a day early to catch a window that started yesterday and is still running.
+	for d := -1; d <= maxMaintenanceLookaheadDays; d++ {
+		day := now.AddDate(0, 0, d)
+		start := time.Date(day.Year(), day.Month(), day.Day(), first.Hour(), first.Minute(), first.Second(), 0, time.UTC)
+		if start.Before(first) || !start.Add(duration).After(now) {
+			continue
+		}
+		if weekdays != nil && !weekdays[start.Weekday()] {
+			continue
+		}
+		if isMaintenanceBlocked(window.GetMaintenanceExclusions(), start) {
+			continue
+		}
+		return start, true
+	}
+
+	return time.Time{}, false
+}
+
+// maintenanceWindowSchedule returns the first occurrence, the duration and the allowed days of the week of the
+// maintenance window. A nil set of weekdays means every day. Only daily and weekly RRULEs are supported.
+func maintenanceWindowSchedule(window *containerpb.MaintenanceWindow) (time.Time, time.Duration, map[time.Weekday]bool, bool) {
+	if daily := window.GetDailyMaintenanceWindow(); daily != nil {
+		start, err := time.Parse("15:04", daily.GetStartTime())
+		if err != nil {
+			return time.Time{}, 0, nil, false
+		}
+		return time.Date(1970, 1, 1, start.Hour(), start.Minute(), 0, 0, time.UTC), dailyMaintenanceWindowDuration, nil, true
+	}
+
+	recurring := window.GetRecurringWindow()
+	if recurring == nil {
+		return time.Time{}, 0, nil, false
+	}
+	first := recurring.GetWindow().GetStartTime().AsTime().UTC()
+	duration := recurring.GetWindow().GetEndTime().AsTime().Sub(first)
+
+	rule := map[string]string{}
+	for _, part := range strings.Split(recurring.GetRecurrence(), ";") {
+		key, value, _ := strings.Cut(part, "=")
+		rule[key] = value
+	}
+	if interval, ok := rule["INTERVAL"]; ok && interval != "1" {
+		return time.Time{}, 0, nil, false
+	}
+
+	switch rule["FREQ"] {
+	case "DAILY":
+		return first, duration, nil, true
+	case "WEEKLY":
+		weekdays := map[time.Weekday]bool{}
+		byDay, ok := rule["BYDAY"]
+		if !ok {
+			weekdays[first.Weekday()] = true
+			return first, duration, weekdays, true
+		}
+		for _, day := range strings.Split(byDay, ",") {
+			weekday, ok := rruleWeekdays[day]
+			if !ok {
+				return time.Time{}, 0, nil, false
+			}
+			weekdays[weekday] = true
+		}
+		return first, duration, weekdays, true
+	default:
+		return time.Time{}, 0, nil, false
+	}
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// isMaintenanceBlocked returns true if a NO_UPGRADES exclusion is in effect at the given time.
+func isMaintenanceBlocked(exclusions map[string]*containerpb.TimeWindow, t time.Time) bool {
+	for _, exclusion := range exclusions {
+		if maintenanceExclusionScope(exclusion) == containerpb.MaintenanceExclusionOptions_NO_UPGRADES && isInTimeWindow(exclusion, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func isInTimeWindow(window *containerpb.TimeWindow, t time.Time) bool {
+	return !t.Before(window.GetStartTime().AsTime()) && t.Before(window.GetEndTime().AsTime())
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestNextMaintenanceTime(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		window *containerpb.MaintenanceWindow
+		want   time.Time
+		wantOk bool
+	}{
+		{
+			name: "daily window later today",
+			window: &containerpb.MaintenanceWindow{
+				Policy: &containerpb.MaintenanceWindow_DailyMaintenanceWindow{
+					DailyMaintenanceWindow: &containerpb.DailyMaintenanceWindow{StartTime: "22:00"},
+				},
+			},
+			want:   time.Date(2024, 5, 15, 22, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name: "daily window in progress",
+			window: &containerpb.MaintenanceWindow{
+				Policy: &containerpb.MaintenanceWindow_DailyMaintenanceWindow{
+					DailyMaintenanceWindow: &containerpb.DailyMaintenanceWindow{StartTime: "08:00"},
+				},
+			},
+			want:   time.Date(2024, 5, 15, 8, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name: "weekly window on weekends",
+			window: &containerpb.MaintenanceWindow{
+				Policy: &containerpb.MaintenanceWindow_RecurringWindow{
+					RecurringWindow: &containerpb.RecurringTimeWindow{
+						Window: &containerpb.TimeWindow{
+							StartTime: timestamppb.New(time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC)),
+							EndTime:   timestamppb.New(time.Date(2024, 1, 6, 8, 0, 0, 0, time.UTC)),
+						},
+						Recurrence: "FREQ=WEEKLY;BYDAY=SA,SU",
+					},
+				},
+			},
+			want:   time.Date(2024, 5, 18, 2, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name: "window blocked by exclusion",
+			window: &containerpb.MaintenanceWindow{
+				Policy: &containerpb.MaintenanceWindow_DailyMaintenanceWindow{
+					DailyMaintenanceWindow: &containerpb.DailyMaintenanceWindow{StartTime: "22:00"},
+				},
+				MaintenanceExclusions: map[string]*containerpb.TimeWindow{
+					"freeze": {
+						StartTime: timestamppb.New(time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)),
+						EndTime:   timestamppb.New(time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+			want:   time.Date(2024, 5, 17, 22, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name: "window not blocked by minor upgrades exclusion",
+			window: &containerpb.MaintenanceWindow{
+				Policy: &containerpb.MaintenanceWindow_DailyMaintenanceWindow{
+					DailyMaintenanceWindow: &containerpb.DailyMaintenanceWindow{StartTime: "22:00"},
+				},
+				MaintenanceExclusions: map[string]*containerpb.TimeWindow{
+					"freeze": {
+						StartTime: timestamppb.New(time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)),
+						EndTime:   timestamppb.New(time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC)),
+						Options: &containerpb.TimeWindow_MaintenanceExclusionOptions{
+							MaintenanceExclusionOptions: &containerpb.MaintenanceExclusionOptions{
+								Scope: containerpb.MaintenanceExclusionOptions_NO_MINOR_UPGRADES,
+							},
+						},
+					},
+				},
+			},
+			want:   time.Date(2024, 5, 15, 22, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name: "unsupported recurrence",
+			window: &containerpb.MaintenanceWindow{
+				Policy: &containerpb.MaintenanceWindow_RecurringWindow{
+					RecurringWindow: &containerpb.RecurringTimeWindow{
+						Window: &containerpb.TimeWindow{
+							StartTime: timestamppb.New(time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC)),
+							EndTime:   timestamppb.New(time.Date(2024, 1, 6, 8, 0, 0, 0, time.UTC)),
+						},
+						Recurrence: "FREQ=MONTHLY;BYMONTHDAY=1",
+					},
+				},
+			},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := nextMaintenanceTime(tt.window, now)
+			if ok != tt.wantOk {
+				t.Fatalf("nextMaintenanceTime() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("nextMaintenanceTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareMaintenancePolicy(t *testing.T) {
+	noMinorUpgrades := infrav1exp.NoMinorUpgrades
+	policy := &infrav1exp.MaintenancePolicy{
+		DailyMaintenanceWindow: &infrav1exp.DailyMaintenanceWindow{StartTime: "03:00"},
+		MaintenanceExclusions: []infrav1exp.MaintenanceExclusion{
+			{
+				Name:      "freeze",
+				StartTime: metav1.NewTime(time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)),
+				EndTime:   metav1.NewTime(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)),
+				Scope:     &noMinorUpgrades,
+			},
+		},
+	}
+
+	live := convertToSdkMaintenancePolicy(policy)
+	// GKE fills in output only fields.
+	live.ResourceVersion = "abc"
+	live.GetWindow().GetDailyMaintenanceWindow().Duration = "PT4H0M0S"
+
+	tests := []struct {
+		name    string
+		desired *infrav1exp.MaintenancePolicy
+		live    *containerpb.MaintenancePolicy
+		want    bool
+	}{
+		{
+			name:    "no policy",
+			desired: nil,
+			live:    nil,
+			want:    true,
+		},
+		{
+			name:    "same policy with output only fields",
+			desired: policy,
+			live:    live,
+			want:    true,
+		},
+		{
+			name: "different start time",
+			desired: &infrav1exp.MaintenancePolicy{
+				DailyMaintenanceWindow: &infrav1exp.DailyMaintenanceWindow{StartTime: "04:00"},
+				MaintenanceExclusions:  policy.MaintenanceExclusions,
+			},
+			live: live,
+			want: false,
+		},
+		{
+			name: "exclusion removed",
+			desired: &infrav1exp.MaintenancePolicy{
+				DailyMaintenanceWindow: policy.DailyMaintenanceWindow,
+			},
+			live: live,
+			want: false,
+		},
+		{
+			name:    "policy removed",
+			desired: nil,
+			live:    live,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareMaintenancePolicy(convertToSdkMaintenancePolicy(tt.desired), tt.live); got != tt.want {
+				t.Errorf("compareMaintenancePolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"cloud.google.com/go/container/apiv1/containerpb"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+var sdkLoggingComponents = map[infrav1exp.LoggingComponent]containerpb.LoggingComponentConfig_Component{
+	infrav1exp.LoggingComponentSystemComponents:  containerpb.LoggingComponentConfig_SYSTEM_COMPONENTS,
+	infrav1exp.LoggingComponentWorkloads:         containerpb.LoggingComponentConfig_WORKLOADS,
+	infrav1exp.LoggingComponentAPIServer:         containerpb.LoggingComponentConfig_APISERVER,
+	infrav1exp.LoggingComponentScheduler:         containerpb.LoggingComponentConfig_SCHEDULER,
+	infrav1exp.LoggingComponentControllerManager: containerpb.LoggingComponentConfig_CONTROLLER_MANAGER,
+}
+
+var sdkMonitoringComponents = map[infrav1exp.MonitoringComponent]containerpb.MonitoringComponentConfig_Component{
+	infrav1exp.MonitoringComponentSystemComponents:  containerpb.MonitoringComponentConfig_SYSTEM_COMPONENTS,
+	infrav1exp.MonitoringComponentAPIServer:         containerpb.MonitoringComponentConfig_APISERVER,
+	infrav1exp.MonitoringComponentScheduler:         containerpb.MonitoringComponentConfig_SCHEDULER,
+	infrav1exp.MonitoringComponentControllerManager: containerpb.MonitoringComponentConfig_CONTROLLER_MANAGER,
+	infrav1exp.MonitoringComponentStorage:           containerpb.MonitoringComponentConfig_STORAGE,
+	infrav1exp.MonitoringComponentHPA:               containerpb.MonitoringComponentConfig_HPA,
+	infrav1exp.MonitoringComponentPod:               containerpb.MonitoringComponentConfig_POD,
+	infrav1exp.MonitoringComponentDaemonSet:         containerpb.MonitoringComponentConfig_DAEMONSET,
+	infrav1exp.MonitoringComponentDeployment:        containerpb.MonitoringComponentConfig_DEPLOYMENT,
+	infrav1exp.MonitoringComponentStatefulSet:       containerpb.MonitoringComponentConfig_STATEFULSET,
+	infrav1exp.MonitoringComponentCAdvisor:          containerpb.MonitoringComponentConfig_CADVISOR,
+	infrav1exp.MonitoringComponentKubelet:           containerpb.MonitoringComponentConfig_KUBELET,
+}
+
+// convertToSdkLoggingConfig converts the LoggingConfig defined in CRs to the SDK version.
+func convertToSdkLoggingConfig(config *infrav1exp.LoggingConfig) *containerpb.LoggingConfig {
+	if config == nil {
+		return nil
+	}
+
+	components := make([]containerpb.LoggingComponentConfig_Component, 0, len(config.EnableComponents))
+	for _, component := range config.EnableComponents {
+		components = append(components, sdkLoggingComponents[component])
+	}
+
+	return &containerpb.LoggingConfig{
+		ComponentConfig: &containerpb.LoggingComponentConfig{
+			EnableComponents: components,
+		},
+	}
+}
+
+// convertToSdkMonitoringConfig converts the MonitoringConfig defined in CRs to the SDK version.
+// Settings that are not specified are left unset.
+func convertToSdkMonitoringConfig(config *infrav1exp.MonitoringConfig) *containerpb.MonitoringConfig {
+	if config == nil {
+		return nil
+	}
+
+	sdkConfig := &containerpb.MonitoringConfig{}
+	if len(config.EnableComponents) > 0 {
+		components := make([]containerpb.MonitoringComponentConfig_Component, 0, len(config.EnableComponents))
+		for _, component := range config.EnableComponents {
+			components = append(components, sdkMonitoringComponents[component])
+		}
+		sdkConfig.ComponentConfig = &containerpb.MonitoringComponentConfig{
+			EnableComponents: components,
+		}
+	}
+	if config.EnableManagedPrometheus != nil {
+		sdkConfig.ManagedPrometheusConfig = &containerpb.ManagedPrometheusConfig{
+			Enabled: *config.EnableManagedPrometheus,
+		}
+	}
+	if config.AdvancedDatapathObservability != nil {
+		enableRelay := config.AdvancedDatapathObservability.EnableRelay
+		sdkConfig.AdvancedDatapathObservabilityConfig = &containerpb.AdvancedDatapathObservabilityConfig{
+			EnableMetrics: config.AdvancedDatapathObservability.EnableMetrics,
+			EnableRelay:   &enableRelay,
+		}
+	}
+
+	return sdkConfig
+}
+
+// compareLoggingConfig returns true if the live cluster sends logs of the desired components.
+func compareLoggingConfig(desired, existing *containerpb.LoggingConfig) bool {
+	if desired == nil {
+		return true
+	}
+
+	return compareComponents(desired.GetComponentConfig().GetEnableComponents(), existing.GetComponentConfig().GetEnableComponents())
+}
+
+// compareMonitoringConfig returns true if every setting specified in desired matches the live cluster.
+func compareMonitoringConfig(desired, existing *containerpb.MonitoringConfig) bool {
+	if desired == nil {
+		return true
+	}
+
+	if desired.ComponentConfig != nil &&
+		!compareComponents(desired.GetComponentConfig().GetEnableComponents(), existing.GetComponentConfig().GetEnableComponents()) {
+		return false
+	}
+	if desired.ManagedPrometheusConfig != nil &&
+		desired.GetManagedPrometheusConfig().GetEnabled() != existing.GetManagedPrometheusConfig().GetEnabled() {
+		return false
+	}
+	if desired.AdvancedDatapathObservabilityConfig != nil {
+		desiredADO, existingADO := desired.GetAdvancedDatapathObservabilityConfig(), existing.GetAdvancedDatapathObservabilityConfig()
+		if desiredADO.GetEnableMetrics() != existingADO.GetEnableMetrics() || desiredADO.GetEnableRelay() != existingADO.GetEnableRelay() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compareComponents returns true if both lists hold the same components, regardless of order.
+func compareComponents[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	set := make(map[T]bool, len(a))
+	for _, component := range a {
+		set[component] = true
+	}
+	for _, component := range b {
+		if !set[component] {
+			return false
+		}
+	}
+
+	return true
+}
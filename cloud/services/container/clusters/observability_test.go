@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestCompareLoggingConfig(t *testing.T) {
+	live := &containerpb.LoggingConfig{
+		ComponentConfig: &containerpb.LoggingComponentConfig{
+			EnableComponents: []containerpb.LoggingComponentConfig_Component{
+				containerpb.LoggingComponentConfig_WORKLOADS,
+				containerpb.LoggingComponentConfig_SYSTEM_COMPONENTS,
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		desired *infrav1exp.LoggingConfig
+		want    bool
+	}{
+		{
+			name:    "not specified",
+			desired: nil,
+			want:    true,
+		},
+		{
+			name: "same components in another order",
+			desired: &infrav1exp.LoggingConfig{
+				EnableComponents: []infrav1exp.LoggingComponent{infrav1exp.LoggingComponentSystemComponents, infrav1exp.LoggingComponentWorkloads},
+			},
+			want: true,
+		},
+		{
+			name: "component added",
+			desired: &infrav1exp.LoggingConfig{
+				EnableComponents: []infrav1exp.LoggingComponent{
+					infrav1exp.LoggingComponentSystemComponents,
+					infrav1exp.LoggingComponentWorkloads,
+					infrav1exp.LoggingComponentAPIServer,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "component removed",
+			desired: &infrav1exp.LoggingConfig{
+				EnableComponents: []infrav1exp.LoggingComponent{infrav1exp.LoggingComponentSystemComponents},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareLoggingConfig(convertToSdkLoggingConfig(tt.desired), live); got != tt.want {
+				t.Errorf("compareLoggingConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareMonitoringConfig(t *testing.T) {
+	live := &containerpb.MonitoringConfig{
+		ComponentConfig: &containerpb.MonitoringComponentConfig{
+			EnableComponents: []containerpb.MonitoringComponentConfig_Component{
+				containerpb.MonitoringComponentConfig_SYSTEM_COMPONENTS,
+			},
+		},
+		ManagedPrometheusConfig: &containerpb.ManagedPrometheusConfig{Enabled: true},
+	}
+
+	tests := []struct {
+		name    string
+		desired *infrav1exp.MonitoringConfig
+		want    bool
+	}{
+		{
+			name:    "not specified",
+			desired: nil,
+			want:    true,
+		},
+		{
+			name: "only managed prometheus specified",
+			desired: &infrav1exp.MonitoringConfig{
+				EnableManagedPrometheus: ptr.To(true),
+			},
+			want: true,
+		},
+		{
+			name: "managed prometheus disabled",
+			desired: &infrav1exp.MonitoringConfig{
+				EnableManagedPrometheus: ptr.To(false),
+			},
+			want: false,
+		},
+		{
+			name: "component added",
+			desired: &infrav1exp.MonitoringConfig{
+				EnableComponents: []infrav1exp.MonitoringComponent{
+					infrav1exp.MonitoringComponentSystemComponents,
+					infrav1exp.MonitoringComponentKubelet,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "advanced datapath observability enabled",
+			desired: &infrav1exp.MonitoringConfig{
+				AdvancedDatapathObservability: &infrav1exp.AdvancedDatapathObservability{EnableMetrics: true},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareMonitoringConfig(convertToSdkMonitoringConfig(tt.desired), live); got != tt.want {
+				t.Errorf("compareMonitoringConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckDiffAndPrepareUpdateMonitoringService(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  *infrav1exp.MonitoringService
+		existing string
+		want     string
+	}{
+		{
+			name:     "unset",
+			existing: "monitoring.googleapis.com/kubernetes",
+		},
+		{
+			name:     "unchanged",
+			desired:  ptr.To(infrav1exp.MonitoringService("monitoring.googleapis.com/kubernetes")),
+			existing: "monitoring.googleapis.com/kubernetes",
+		},
+		{
+			name:     "changed",
+			desired:  ptr.To(infrav1exp.MonitoringService("none")),
+			existing: "monitoring.googleapis.com/kubernetes",
+			want:     "none",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(&scope.ManagedControlPlaneScope{
+				GCPManagedControlPlane: &infrav1exp.GCPManagedControlPlane{
+					Spec: infrav1exp.GCPManagedControlPlaneSpec{MonitoringService: tt.desired},
+				},
+			})
+			cluster := newUnchangedCluster()
+			cluster.MonitoringService = tt.existing
+			log := logr.Discard()
+			needUpdate, request := s.checkDiffAndPrepareUpdate(cluster, false, &log)
+			if needUpdate != (tt.want != "") {
+				t.Fatalf("checkDiffAndPrepareUpdate() needUpdate = %t, want %t: %v", needUpdate, tt.want != "", request.GetUpdate())
+			}
+			if got := request.GetUpdate().GetDesiredMonitoringService(); got != tt.want {
+				t.Errorf("checkDiffAndPrepareUpdate() DesiredMonitoringService = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"encoding/base64"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	credentials "cloud.google.com/go/iam/credentials/apiv1"
+	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
+	"github.com/google/go-cmp/cmp"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"google.golang.org/api/option"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/container/internal/testutil"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/shared"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	clusterv1exp "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConvertFromSdkOperation(t *testing.T) {
+	startTime := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		operation *containerpb.Operation
+		want      *infrav1exp.GKEOperation
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "running with stages",
+			operation: &containerpb.Operation{
+				Name:          "operation-1",
+				OperationType: containerpb.Operation_CREATE_CLUSTER,
+				Status:        containerpb.Operation_RUNNING,
+				StartTime:     startTime.Format(time.RFC3339),
+				Progress: &containerpb.OperationProgress{
+					Stages: []*containerpb.OperationProgress{
+						{Status: containerpb.Operation_DONE},
+						{Status: containerpb.Operation_RUNNING},
+						{Status: containerpb.Operation_PENDING},
+						{Status: containerpb.Operation_PENDING},
+					},
+				},
+			},
+			want: &infrav1exp.GKEOperation{
+				Name:      "operation-1",
+				Type:      "CREATE_CLUSTER",
+				Status:    "RUNNING",
+				Progress:  25,
+				StartTime: &metav1.Time{Time: startTime},
+			},
+		},
+		{
+			name: "failed with node progress",
+			operation: &containerpb.Operation{
+				Name:          "operation-2",
+				OperationType: containerpb.Operation_UPGRADE_NODES,
+				Status:        containerpb.Operation_DONE,
+				Progress: &containerpb.OperationProgress{
+					Metrics: []*containerpb.OperationProgress_Metric{
+						{Name: "NODES_DONE", Value: &containerpb.OperationProgress_Metric_IntValue{IntValue: 3}},
+						{Name: "NODES_TOTAL", Value: &containerpb.OperationProgress_Metric_IntValue{IntValue: 4}},
+					},
+				},
+				Error: &status.Status{Code: int32(code.Code_RESOURCE_EXHAUSTED), Message: "quota exceeded"},
+			},
+			want: &infrav1exp.GKEOperation{
+				Name:     "operation-2",
+				Type:     "UPGRADE_NODES",
+				Status:   "DONE",
+				Progress: 75,
+				Error:    "quota exceeded",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, infrav1exp.ConvertFromSdkOperation(tt.operation)); diff != "" {
+				t.Errorf("ConvertFromSdkOperation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStatusConditions(t *testing.T) {
+	statusConditions := []*containerpb.StatusCondition{
+		{CanonicalCode: code.Code_RESOURCE_EXHAUSTED, Message: "Insufficient regional quota to satisfy request"},
+		{Message: "Node pool default-pool is unhealthy"},
+		{CanonicalCode: code.Code_INTERNAL},
+	}
+
+	wantMessage := "RESOURCE_EXHAUSTED: Insufficient regional quota to satisfy request; Node pool default-pool is unhealthy"
+	if got := shared.StatusConditionsMessage(statusConditions); got != wantMessage {
+		t.Errorf("StatusConditionsMessage() = %q, want %q", got, wantMessage)
+	}
+
+	want := []infrav1exp.GKEStatusCondition{
+		{Code: "RESOURCE_EXHAUSTED", Message: "Insufficient regional quota to satisfy request"},
+		{Message: "Node pool default-pool is unhealthy"},
+		{Code: "INTERNAL"},
+	}
+	if diff := cmp.Diff(want, infrav1exp.ConvertFromSdkStatusConditions(statusConditions)); diff != "" {
+		t.Errorf("ConvertFromSdkStatusConditions() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// fakeClusterManager serves the GKE operation, cluster and server config the cluster reconciliation reads.
+type fakeClusterManager struct {
+	containerpb.UnimplementedClusterManagerServer
+	operation *containerpb.Operation
+	cluster   *containerpb.Cluster
+	// serverConfig is returned by GetServerConfig, which defaults to a config with the current version only.
+	serverConfig *containerpb.ServerConfig
+	// serverConfigErr is returned by GetServerConfig when set.
+	serverConfigErr error
+	// serverConfigRequests counts the GetServerConfig requests.
+	serverConfigRequests atomic.Int32
+}
+
+func (f *fakeClusterManager) GetOperation(context.Context, *containerpb.GetOperationRequest) (*containerpb.Operation, error) {
+	return f.operation, nil
+}
+
+func (f *fakeClusterManager) GetCluster(context.Context, *containerpb.GetClusterRequest) (*containerpb.Cluster, error) {
+	return f.cluster, nil
+}
+
+func (f *fakeClusterManager) GetServerConfig(context.Context, *containerpb.GetServerConfigRequest) (*containerpb.ServerConfig, error) {
+	f.serverConfigRequests.Add(1)
+	if f.serverConfigErr != nil {
+		return nil, f.serverConfigErr
+	}
+	if f.serverConfig != nil {
+		return f.serverConfig, nil
+	}
+	return &containerpb.ServerConfig{ValidMasterVersions: []string{f.cluster.GetCurrentMasterVersion()}}, nil
+}
+
+func TestReconcileFailedOperation(t *testing.T) {
+	cluster := newUnchangedCluster()
+	cluster.Status = containerpb.Cluster_RUNNING
+	cluster.CurrentMasterVersion = "1.30.5-gke.1014001"
+	cluster.Endpoint = "10.0.0.1"
+	cluster.MasterAuth = &containerpb.MasterAuth{ClusterCaCertificate: base64.StdEncoding.EncodeToString([]byte("ca"))}
+	server := &fakeClusterManager{
+		operation: &containerpb.Operation{
+			Name:          "operation-1",
+			OperationType: containerpb.Operation_UPGRADE_MASTER,
+			Status:        containerpb.Operation_DONE,
+			Error:         &status.Status{Code: int32(code.Code_FAILED_PRECONDITION), Message: "upgrade failed"},
+		},
+		cluster: cluster,
+	}
+
+	gcpManagedControlPlane := &infrav1exp.GCPManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-control-plane",
+			Namespace: "default",
+		},
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			ClusterName: "my-cluster",
+			Project:     "my-project",
+			Location:    "us-central1",
+			Import:      true,
+			Kubeconfig: &infrav1exp.KubeconfigConfig{
+				CAPIAuthMode: infrav1exp.KubeconfigExecPlugin,
+			},
+		},
+		Status: infrav1exp.GCPManagedControlPlaneStatus{
+			Imported: true,
+			Operation: &infrav1exp.GKEOperation{
+				Name:   "operation-1",
+				Type:   "UPGRADE_MASTER",
+				Status: "RUNNING",
+			},
+		},
+	}
+	managedControlPlaneScope := newTestManagedControlPlaneScope(t, server, gcpManagedControlPlane, nil)
+
+	// The failure of the operation is reported by the pass that sees it complete, and is kept by the next ones
+	// although the cluster is running and up to date.
+	s := New(managedControlPlaneScope)
+	for range 2 {
+		if _, err := s.Reconcile(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+		for _, conditionType := range []clusterv1.ConditionType{clusterv1.ReadyCondition, infrav1exp.GKEControlPlaneReadyCondition} {
+			if !conditions.IsFalse(gcpManagedControlPlane, conditionType) {
+				t.Errorf("Reconcile() set condition %s to %v, want False", conditionType, conditions.Get(gcpManagedControlPlane, conditionType))
+			}
+			if reason := conditions.GetReason(gcpManagedControlPlane, conditionType); reason != infrav1exp.GKEControlPlaneOperationFailedReason {
+				t.Errorf("Reconcile() set condition %s reason to %q, want %q", conditionType, reason, infrav1exp.GKEControlPlaneOperationFailedReason)
+			}
+		}
+	}
+
+	// A new operation replaces the failed one.
+	gcpManagedControlPlane.Status.Operation = &infrav1exp.GKEOperation{Name: "operation-2", Status: "DONE"}
+	if _, err := s.Reconcile(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if !conditions.IsTrue(gcpManagedControlPlane, clusterv1.ReadyCondition) {
+		t.Errorf("Reconcile() set condition Ready to %v after a new operation, want True", conditions.Get(gcpManagedControlPlane, clusterv1.ReadyCondition))
+	}
+}
+
+// newTestManagedControlPlaneScope returns a scope for the control plane using the given GKE server and, when set,
+// GKE Hub service.
+func newTestManagedControlPlaneScope(t *testing.T, server containerpb.ClusterManagerServer, gcpManagedControlPlane *infrav1exp.GCPManagedControlPlane, gkeHubService *gkehub.Service) *scope.ManagedControlPlaneScope {
+	t.Helper()
+	credentialsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gcp-credentials",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"credentials": []byte(`{"project_id": "my-project"}`),
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{corev1.AddToScheme, clusterv1exp.AddToScheme, infrav1exp.AddToScheme} {
+		if err := addToScheme(scheme); err != nil {
+			t.Fatal(err)
+		}
+	}
+	credentialsClient, err := credentials.NewIamCredentialsClient(context.TODO(), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagBindingsClient, err := resourcemanager.NewTagBindingsClient(context.TODO(), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gkeHubService == nil {
+		gkeHubService, err = gkehub.NewService(context.TODO(), option.WithoutAuthentication())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	managedControlPlaneScope, err := scope.NewManagedControlPlaneScope(context.TODO(), scope.ManagedControlPlaneScopeParams{
+		CredentialsClient:    credentialsClient,
+		ManagedClusterClient: testutil.NewFakeClusterManagerClient(t, server),
+		TagBindingsClient:    tagBindingsClient,
+		GKEHubService:        gkeHubService,
+		Client:               fake.NewClientBuilder().WithScheme(scheme).WithObjects(credentialsSecret, gcpManagedControlPlane).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-cluster",
+				Namespace: "default",
+			},
+		},
+		GCPManagedCluster: &infrav1exp.GCPManagedCluster{
+			Spec: infrav1exp.GCPManagedClusterSpec{
+				CredentialsRef: &infrav1.ObjectReference{Name: "gcp-credentials", Namespace: "default"},
+			},
+		},
+		GCPManagedControlPlane: gcpManagedControlPlane,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return managedControlPlaneScope
+}
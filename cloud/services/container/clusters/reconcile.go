@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/shared"
@@ -44,6 +45,15 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	log := log.FromContext(ctx).WithValues("service", "container.clusters")
 	log.Info("Reconciling cluster resources")
 
+	operationInProgress, err := s.reconcileOperation(ctx, &log)
+	if err != nil {
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEControlPlaneReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{}, err
+	}
+	if operationInProgress {
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
+
 	cluster, err := s.describeCluster(ctx, &log)
 	if err != nil {
 		s.scope.GCPManagedControlPlane.Status.Initialized = false
@@ -51,6 +61,13 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEControlPlaneReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return ctrl.Result{}, err
 	}
+	if cluster == nil && s.scope.GCPManagedControlPlane.Spec.Import {
+		err := errors.New("GKE cluster to import not found")
+		log.Error(err, "Failed to import cluster", "name", s.scope.ClusterName())
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEControlPlaneReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition, infrav1exp.GKEControlPlaneReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
 	if cluster == nil {
 		log.Info("Cluster not found, creating")
 		s.scope.GCPManagedControlPlane.Status.Initialized = false
@@ -96,7 +113,19 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	}
 
 	log.V(2).Info("gke cluster found", "status", cluster.GetStatus())
+	if err := s.reconcileOwnership(ctx, cluster, &log); err != nil {
+		log.Error(err, "Failed to reconcile GKE cluster ownership")
+		reason := infrav1exp.GKEControlPlaneReconciliationFailedReason
+		if errors.Is(err, ErrUnownedCluster) {
+			reason = infrav1exp.GKEControlPlaneNotOwnedReason
+		}
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, reason, clusterv1.ConditionSeverityError, err.Error())
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition, reason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{}, err
+	}
 	s.scope.GCPManagedControlPlane.Status.CurrentVersion = convertToSdkMasterVersion(cluster.GetCurrentMasterVersion())
+	s.scope.GCPManagedControlPlane.Status.GKEConditions = infrav1exp.ConvertFromSdkStatusConditions(cluster.GetConditions())
+	s.scope.GCPManagedControlPlane.Status.MaintenancePolicy = convertFromSdkMaintenancePolicy(cluster.GetMaintenancePolicy(), time.Now())
 
 	switch cluster.GetStatus() {
 	case containerpb.Cluster_PROVISIONING:
@@ -122,12 +151,13 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		s.scope.GCPManagedControlPlane.Status.Ready = false
 		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
 	case containerpb.Cluster_ERROR, containerpb.Cluster_DEGRADED:
-		var msg string
-		if len(cluster.GetConditions()) > 0 {
-			msg = cluster.GetConditions()[0].GetMessage()
+		msg := shared.StatusConditionsMessage(cluster.GetConditions())
+		if msg == "" {
+			msg = fmt.Sprintf("GKE cluster is in %s state", cluster.GetStatus())
 		}
 		log.Error(errors.New("Cluster in error/degraded state"), msg, "name", s.scope.ClusterName())
-		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition, infrav1exp.GKEControlPlaneErrorReason, clusterv1.ConditionSeverityError, "")
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEControlPlaneErrorReason, clusterv1.ConditionSeverityError, "%s", msg)
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition, infrav1exp.GKEControlPlaneErrorReason, clusterv1.ConditionSeverityError, "%s", msg)
 		s.scope.GCPManagedControlPlane.Status.Ready = false
 		s.scope.GCPManagedControlPlane.Status.Initialized = false
 		return ctrl.Result{}, nil
@@ -139,7 +169,25 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		return ctrl.Result{}, statusErr
 	}
 
-	needUpdate, updateClusterRequest := s.checkDiffAndPrepareUpdate(cluster, &log)
+	_, securityMismatches := s.diffSecurityPosture(cluster)
+	if len(securityMismatches) > 0 {
+		log.Info("Cluster security settings do not match the spec", "mismatches", securityMismatches)
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEControlPlaneSecurityPostureMismatchReason, clusterv1.ConditionSeverityWarning, "%s", strings.Join(securityMismatches, "; "))
+	}
+
+	upgradeBlocked, err := s.reconcileVersion(ctx, cluster, &log)
+	if err != nil {
+		log.Error(err, "Failed to validate control plane version")
+		return ctrl.Result{}, err
+	}
+
+	// Network policy enforcement must be disabled before the network policy add-on, and enabled after it.
+	needNetworkPolicyUpdate, setNetworkPolicyRequest := s.checkDiffAndPrepareNetworkPolicy(cluster, &log)
+	if needNetworkPolicyUpdate && !setNetworkPolicyRequest.GetNetworkPolicy().GetEnabled() {
+		return s.reconcileNetworkPolicy(ctx, setNetworkPolicyRequest, &log)
+	}
+
+	needUpdate, updateClusterRequest := s.checkDiffAndPrepareUpdate(cluster, upgradeBlocked, &log)
 	if needUpdate {
 		log.Info("Update required")
 		err = s.updateCluster(ctx, updateClusterRequest, &log)
@@ -150,32 +198,95 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneUpdatingCondition)
 		s.scope.GCPManagedControlPlane.Status.Initialized = true
 		s.scope.GCPManagedControlPlane.Status.Ready = true
-		return ctrl.Result{}, nil
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
+
+	if needNetworkPolicyUpdate {
+		return s.reconcileNetworkPolicy(ctx, setNetworkPolicyRequest, &log)
+	}
+
+	needUpdate, setMaintenancePolicyRequest := s.checkDiffAndPrepareMaintenancePolicy(cluster, &log)
+	if needUpdate {
+		log.Info("Maintenance policy update required")
+		err = s.setMaintenancePolicy(ctx, setMaintenancePolicyRequest, &log)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("Cluster maintenance policy updating in progress")
+		conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneUpdatingCondition)
+		s.scope.GCPManagedControlPlane.Status.Initialized = true
+		s.scope.GCPManagedControlPlane.Status.Ready = true
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
 	}
 	conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneUpdatingCondition, infrav1exp.GKEControlPlaneUpdatedReason, clusterv1.ConditionSeverityInfo, "")
 
+	// The kubeconfigs and the endpoint all use the selected control plane endpoint, which must exist.
+	endpoint, _, err := controlPlaneEndpoint(cluster, s.scope.GCPManagedControlPlane.Spec.EndpointType)
+	if err != nil {
+		log.Error(err, "Failed to get control plane endpoint")
+		reason := infrav1exp.GKEControlPlaneReconciliationFailedReason
+		if errors.Is(err, ErrControlPlaneEndpointNotFound) {
+			reason = infrav1exp.GKEControlPlaneEndpointNotFoundReason
+		}
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, reason, clusterv1.ConditionSeverityError, err.Error())
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition, reason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{}, err
+	}
+
 	// Reconcile kubeconfig
-	err = s.reconcileKubeconfig(ctx, cluster, &log)
+	capiRefreshAfter, err := s.reconcileKubeconfig(ctx, cluster, &log)
 	if err != nil {
 		log.Error(err, "Failed to reconcile CAPI kubeconfig")
 		return ctrl.Result{}, err
 	}
-	err = s.reconcileAdditionalKubeconfigs(ctx, cluster, &log)
+	userRefreshAfter, err := s.reconcileAdditionalKubeconfigs(ctx, cluster, &log)
 	if err != nil {
 		log.Error(err, "Failed to reconcile additional kubeconfig")
 		return ctrl.Result{}, err
 	}
 
-	s.scope.SetEndpoint(cluster.GetEndpoint())
-	conditions.MarkTrue(s.scope.ConditionSetter(), clusterv1.ReadyCondition)
-	conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition)
+	s.scope.SetEndpoint(endpoint)
+
+	fleetPending, err := s.reconcileFleet(ctx, &log)
+	if err != nil {
+		log.Error(err, "Failed to reconcile fleet membership")
+		return ctrl.Result{}, err
+	}
+
+	if !s.operationFailed() {
+		if len(securityMismatches) == 0 {
+			conditions.MarkTrue(s.scope.ConditionSetter(), clusterv1.ReadyCondition)
+		}
+		conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneReadyCondition)
+	}
 	conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneCreatingCondition, infrav1exp.GKEControlPlaneCreatedReason, clusterv1.ConditionSeverityInfo, "")
 	s.scope.GCPManagedControlPlane.Status.Ready = true
 	s.scope.GCPManagedControlPlane.Status.Initialized = true
 
 	log.Info("Cluster reconciled")
 
-	return ctrl.Result{}, nil
+	// Requeue in time to refresh the kubeconfig tokens before they expire, or to finish the fleet setup.
+	requeueAfter := capiRefreshAfter
+	if userRefreshAfter > 0 && (requeueAfter == 0 || userRefreshAfter < requeueAfter) {
+		requeueAfter = userRefreshAfter
+	}
+	if fleetPending && (requeueAfter == 0 || reconciler.DefaultRetryTime < requeueAfter) {
+		requeueAfter = reconciler.DefaultRetryTime
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (s *Service) reconcileNetworkPolicy(ctx context.Context, setNetworkPolicyRequest *containerpb.SetNetworkPolicyRequest, log *logr.Logger) (ctrl.Result, error) {
+	log.Info("Network policy update required")
+	if err := s.setNetworkPolicy(ctx, setNetworkPolicyRequest, log); err != nil {
+		return ctrl.Result{}, err
+	}
+	log.Info("Cluster network policy updating in progress")
+	conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneUpdatingCondition)
+	s.scope.GCPManagedControlPlane.Status.Initialized = true
+	s.scope.GCPManagedControlPlane.Status.Ready = true
+	return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
 }
 
 // Delete delete GKE cluster.
@@ -187,6 +298,18 @@ func (s *Service) Delete(ctx context.Context) (ctrl.Result, error) {
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	if cluster != nil && !s.isClusterOwned(cluster) && !s.scope.GCPManagedControlPlane.Spec.AllowUnownedDeletion {
+		log.Info("Cluster was not created by Cluster API, leaving it in place")
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneDeletingCondition, infrav1exp.GKEControlPlaneDeletedReason, clusterv1.ConditionSeverityInfo,
+			"GKE cluster was not created by Cluster API and was left in place")
+		return ctrl.Result{}, nil
+	}
+
+	// The cluster is unregistered from its fleet before it is deleted, as the membership would otherwise point to
+	// a deleted cluster.
+	if err := s.unregisterFleetMembership(ctx, &log); err != nil {
+		return ctrl.Result{}, err
+	}
 	if cluster == nil {
 		log.Info("Cluster already deleted")
 		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneDeletingCondition, infrav1exp.GKEControlPlaneDeletedReason, clusterv1.ConditionSeverityInfo, "")
@@ -256,9 +379,7 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 		Description: s.scope.GCPManagedControlPlane.Spec.Description,
 		Network:     *s.scope.GCPManagedCluster.Spec.Network.Name,
 		Subnetwork:  s.getSubnetNameInClusterRegion(),
-		Autopilot: &containerpb.Autopilot{
-			Enabled: s.scope.GCPManagedControlPlane.Spec.EnableAutopilot,
-		},
+		Autopilot:   convertToSdkAutopilot(s.scope.GCPManagedControlPlane.Spec.EnableAutopilot, s.scope.GCPManagedControlPlane.Spec.Autopilot),
 		IdentityServiceConfig: &containerpb.IdentityServiceConfig{
 			Enabled: s.scope.GCPManagedControlPlane.Spec.EnableIdentityService,
 		},
@@ -269,16 +390,63 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
 				AuthorizedNetworksConfig: convertToSdkMasterAuthorizedNetworksConfig(s.scope.GCPManagedControlPlane.Spec.MasterAuthorizedNetworksConfig),
 			},
+			DnsEndpointConfig: convertToSdkDNSEndpointConfig(s.scope.GCPManagedControlPlane.Spec.DNSEndpoint),
 		},
+		AuthenticatorGroupsConfig: convertToSdkAuthenticatorGroupsConfig(s.scope.GCPManagedControlPlane.Spec.AuthenticatorGroupConfig),
+		AddonsConfig:              convertToSdkAddonsConfig(s.scope.GCPManagedControlPlane.Spec.AddonsConfig),
+		BinaryAuthorization:       convertToSdkBinaryAuthorization(s.scope.GCPManagedControlPlane.Spec.BinaryAuthorization),
+		ShieldedNodes:             convertToSdkShieldedNodes(s.scope.GCPManagedControlPlane.Spec.EnableShieldedNodes),
+		SecurityPostureConfig:     convertToSdkSecurityPostureConfig(s.scope.GCPManagedControlPlane.Spec.SecurityPosture),
+		DatabaseEncryption:        convertToSdkDatabaseEncryption(s.scope.GCPManagedControlPlane.Spec.DatabaseEncryption),
+		LoggingConfig:             convertToSdkLoggingConfig(s.scope.GCPManagedControlPlane.Spec.LoggingConfig),
+		MonitoringConfig:          convertToSdkMonitoringConfig(s.scope.GCPManagedControlPlane.Spec.MonitoringConfig),
+		ResourceUsageExportConfig: convertToSdkResourceUsageExportConfig(s.scope.GCPManagedControlPlane.Spec.ResourceUsageExport),
+		CostManagementConfig:      convertToSdkCostManagementConfig(s.scope.GCPManagedControlPlane.Spec.EnableCostAllocation),
+		Autoscaling:               convertToSdkClusterAutoscaling(s.scope.GCPManagedControlPlane.Spec.ClusterAutoscaling),
+		ResourceLabels:            s.scope.ClusterResourceLabels(),
+		NodePoolAutoConfig:        convertToSdkNodePoolAutoConfig(s.scope.GCPManagedControlPlane.Spec.Autopilot),
+		SecretManagerConfig:       convertToSdkSecretManagerConfig(s.scope.GCPManagedControlPlane.Spec.AddonsConfig),
+	}
+	if s.scope.IsAutopilotCluster() && s.scope.GCPManagedControlPlane.Spec.Autopilot != nil {
+		cluster.Autoscaling = convertToSdkAutopilotAutoscaling(s.scope.GCPManagedControlPlane.Spec.Autopilot)
+	}
+	if cluster.GetAddonsConfig().GetNetworkPolicyConfig() != nil && !cluster.GetAddonsConfig().GetNetworkPolicyConfig().GetDisabled() {
+		// The network policy add-on only deploys the control plane components, enforcement on nodes must be enabled too.
+		cluster.NetworkPolicy = &containerpb.NetworkPolicy{
+			Provider: containerpb.NetworkPolicy_CALICO,
+			Enabled:  true,
+		}
+	}
+	if s.scope.GCPManagedControlPlane.Spec.MaintenancePolicy != nil {
+		cluster.MaintenancePolicy = convertToSdkMaintenancePolicy(s.scope.GCPManagedControlPlane.Spec.MaintenancePolicy)
+	}
+	if s.scope.GCPManagedControlPlane.Spec.WorkloadIdentityConfig != nil {
+		cluster.WorkloadIdentityConfig = convertToSdkWorkloadIdentityConfig(s.scope.GCPManagedControlPlane.Spec.WorkloadIdentityConfig)
 	}
 	if s.scope.GCPManagedControlPlane.Spec.ControlPlaneVersion != nil {
 		cluster.InitialClusterVersion = convertToSdkMasterVersion(*s.scope.GCPManagedControlPlane.Spec.ControlPlaneVersion)
 	}
 	if s.scope.GCPManagedControlPlane.Spec.ClusterNetwork != nil {
 		cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork
+		cluster.NetworkConfig = &containerpb.NetworkConfig{
+			DatapathProvider:          convertToSdkDatapathProvider(cn.DatapathProvider),
+			DnsConfig:                 convertToSdkDNSConfig(cn.DNSConfig),
+			EnableIntraNodeVisibility: cn.EnableIntraNodeVisibility,
+		}
 		if cn.UseIPAliases {
 			cluster.IpAllocationPolicy = &containerpb.IPAllocationPolicy{}
 			cluster.IpAllocationPolicy.UseIpAliases = cn.UseIPAliases
+			if cn.Pod != nil {
+				cluster.IpAllocationPolicy.ClusterIpv4CidrBlock = cn.Pod.CidrBlock
+				cluster.IpAllocationPolicy.ClusterSecondaryRangeName = cn.Pod.SecondaryRangeName
+			}
+			if cn.Service != nil {
+				cluster.IpAllocationPolicy.ServicesIpv4CidrBlock = cn.Service.CidrBlock
+				cluster.IpAllocationPolicy.ServicesSecondaryRangeName = cn.Service.SecondaryRangeName
+			}
+		} else if cn.Pod != nil {
+			// Routes based clusters only support a pod range.
+			cluster.ClusterIpv4Cidr = cn.Pod.CidrBlock
 		}
 		if cn.PrivateCluster != nil {
 			cluster.PrivateClusterConfig = &containerpb.PrivateClusterConfig{}
@@ -295,10 +463,8 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 			cluster.PrivateClusterConfig.MasterIpv4CidrBlock = cn.PrivateCluster.ControlPlaneCidrBlock
 			cluster.ControlPlaneEndpointsConfig.IpEndpointsConfig.GlobalAccess = &cn.PrivateCluster.ControlPlaneGlobalAccess
 
-			cluster.NetworkConfig = &containerpb.NetworkConfig{
-				DefaultSnatStatus: &containerpb.DefaultSnatStatus{
-					Disabled: cn.PrivateCluster.DisableDefaultSNAT,
-				},
+			cluster.NetworkConfig.DefaultSnatStatus = &containerpb.DefaultSnatStatus{
+				Disabled: cn.PrivateCluster.DisableDefaultSNAT,
 			}
 		}
 	}
@@ -318,11 +484,12 @@ func (s *Service) createCluster(ctx context.Context, log *logr.Logger) error {
 	}
 
 	log.V(2).Info("Creating GKE cluster")
-	_, err := s.scope.ManagedControlPlaneClient().CreateCluster(ctx, createClusterRequest)
+	operation, err := s.scope.ManagedControlPlaneClient().CreateCluster(ctx, createClusterRequest)
 	if err != nil {
 		log.Error(err, "Error creating GKE cluster", "name", s.scope.ClusterName())
 		return err
 	}
+	s.setOperation(operation)
 
 	err = shared.ResourceTagBinding(
 		ctx,
@@ -349,11 +516,12 @@ func (s *Service) getSubnetNameInClusterRegion() string {
 }
 
 func (s *Service) updateCluster(ctx context.Context, updateClusterRequest *containerpb.UpdateClusterRequest, log *logr.Logger) error {
-	_, err := s.scope.ManagedControlPlaneClient().UpdateCluster(ctx, updateClusterRequest)
+	operation, err := s.scope.ManagedControlPlaneClient().UpdateCluster(ctx, updateClusterRequest)
 	if err != nil {
 		log.Error(err, "Error updating GKE cluster", "name", s.scope.ClusterName())
 		return err
 	}
+	s.setOperation(operation)
 
 	return nil
 }
@@ -362,11 +530,12 @@ func (s *Service) deleteCluster(ctx context.Context, log *logr.Logger) error {
 	deleteClusterRequest := &containerpb.DeleteClusterRequest{
 		Name: s.scope.ClusterFullName(),
 	}
-	_, err := s.scope.ManagedControlPlaneClient().DeleteCluster(ctx, deleteClusterRequest)
+	operation, err := s.scope.ManagedControlPlaneClient().DeleteCluster(ctx, deleteClusterRequest)
 	if err != nil {
 		log.Error(err, "Error deleting GKE cluster", "name", s.scope.ClusterName())
 		return err
 	}
+	s.setOperation(operation)
 
 	return nil
 }
@@ -419,51 +588,244 @@ func convertToSdkMasterAuthorizedNetworksConfig(config *infrav1exp.MasterAuthori
 	}
 }
 
-func (s *Service) checkDiffAndPrepareUpdate(existingCluster *containerpb.Cluster, log *logr.Logger) (bool, *containerpb.UpdateClusterRequest) {
+// convertToSdkDatapathProvider converts the DatapathProvider defined in CRs to the SDK version.
+func convertToSdkDatapathProvider(provider *infrav1exp.DatapathProvider) containerpb.DatapathProvider {
+	if provider == nil {
+		return containerpb.DatapathProvider_DATAPATH_PROVIDER_UNSPECIFIED
+	}
+	switch *provider {
+	case infrav1exp.LegacyDatapath:
+		return containerpb.DatapathProvider_LEGACY_DATAPATH
+	case infrav1exp.AdvancedDatapath:
+		return containerpb.DatapathProvider_ADVANCED_DATAPATH
+	default:
+		return containerpb.DatapathProvider_DATAPATH_PROVIDER_UNSPECIFIED
+	}
+}
+
+// convertToSdkDNSConfig converts the ClusterDNSConfig defined in CRs to the SDK version.
+func convertToSdkDNSConfig(config *infrav1exp.ClusterDNSConfig) *containerpb.DNSConfig {
+	if config == nil {
+		return nil
+	}
+
+	sdkConfig := &containerpb.DNSConfig{
+		ClusterDnsDomain: config.Domain,
+	}
+	switch config.Provider {
+	case infrav1exp.PlatformDefaultDNS:
+		sdkConfig.ClusterDns = containerpb.DNSConfig_PLATFORM_DEFAULT
+	case infrav1exp.CloudDNS:
+		sdkConfig.ClusterDns = containerpb.DNSConfig_CLOUD_DNS
+	case infrav1exp.KubeDNS:
+		sdkConfig.ClusterDns = containerpb.DNSConfig_KUBE_DNS
+	}
+	if config.Scope != nil {
+		switch *config.Scope {
+		case infrav1exp.ClusterDNSScopeCluster:
+			sdkConfig.ClusterDnsScope = containerpb.DNSConfig_CLUSTER_SCOPE
+		case infrav1exp.ClusterDNSScopeVPC:
+			sdkConfig.ClusterDnsScope = containerpb.DNSConfig_VPC_SCOPE
+		}
+	}
+
+	return sdkConfig
+}
+
+// convertToSdkWorkloadIdentityConfig converts the WorkloadIdentityConfig defined in CRs to the SDK version.
+func convertToSdkWorkloadIdentityConfig(config *infrav1exp.WorkloadIdentityConfig) *containerpb.WorkloadIdentityConfig {
+	// if config is nil, it means that the user wants to disable the feature.
+	if config == nil {
+		return &containerpb.WorkloadIdentityConfig{}
+	}
+
+	return &containerpb.WorkloadIdentityConfig{
+		WorkloadPool: config.WorkloadPool,
+	}
+}
+
+// convertToSdkAuthenticatorGroupsConfig converts the AuthenticatorGroupConfig defined in CRs to the SDK version.
+func convertToSdkAuthenticatorGroupsConfig(config *infrav1exp.AuthenticatorGroupConfig) *containerpb.AuthenticatorGroupsConfig {
+	// if config is nil, it means that the user wants to disable the feature.
+	if config == nil {
+		return &containerpb.AuthenticatorGroupsConfig{
+			Enabled: false,
+		}
+	}
+
+	return &containerpb.AuthenticatorGroupsConfig{
+		Enabled:       true,
+		SecurityGroup: config.SecurityGroups,
+	}
+}
+
+// checkDiffAndPrepareUpdate compares the spec with the existing cluster and returns a request for the first
+// setting that differs. Settings are updated in the order they are checked here, one per request.
+func (s *Service) checkDiffAndPrepareUpdate(existingCluster *containerpb.Cluster, upgradeBlocked bool, log *logr.Logger) (bool, *containerpb.UpdateClusterRequest) {
 	log.V(4).Info("Checking diff and preparing update.")
 
-	needUpdate := false
-	clusterUpdate := containerpb.ClusterUpdate{}
+	var updates []*containerpb.ClusterUpdate
 	// Release channel
 	desiredReleaseChannel := convertToSdkReleaseChannel(s.scope.GCPManagedControlPlane.Spec.ReleaseChannel)
 	if desiredReleaseChannel != existingCluster.GetReleaseChannel().GetChannel() {
 		log.V(2).Info("Release channel update required", "current", existingCluster.GetReleaseChannel().GetChannel(), "desired", desiredReleaseChannel)
-		needUpdate = true
-		clusterUpdate.DesiredReleaseChannel = &containerpb.ReleaseChannel{
-			Channel: desiredReleaseChannel,
-		}
+		updates = append(updates, &containerpb.ClusterUpdate{
+			DesiredReleaseChannel: &containerpb.ReleaseChannel{
+				Channel: desiredReleaseChannel,
+			},
+		})
 	}
-	// Master version
-	if s.scope.GCPManagedControlPlane.Spec.ControlPlaneVersion != nil {
+	// Master version, unless the upgrade would break the version guardrails
+	if s.scope.GCPManagedControlPlane.Spec.ControlPlaneVersion != nil && !upgradeBlocked {
 		desiredMasterVersion := convertToSdkMasterVersion(*s.scope.GCPManagedControlPlane.Spec.ControlPlaneVersion)
 		existingClusterMasterVersion := convertToSdkMasterVersion(existingCluster.GetCurrentMasterVersion())
 		if desiredMasterVersion != existingClusterMasterVersion {
-			needUpdate = true
-			clusterUpdate.DesiredMasterVersion = desiredMasterVersion
+			updates = append(updates, &containerpb.ClusterUpdate{DesiredMasterVersion: desiredMasterVersion})
 			log.V(2).Info("Master version update required", "current", existingClusterMasterVersion, "desired", desiredMasterVersion)
 		}
 	}
 
 	// LoggingService
-	if existingCluster.GetLoggingService() != s.scope.GCPManagedControlPlane.Spec.LoggingService.String() {
-		needUpdate = true
-		clusterUpdate.DesiredLoggingService = s.scope.GCPManagedControlPlane.Spec.LoggingService.String()
+	if s.scope.GCPManagedControlPlane.Spec.LoggingService != nil && existingCluster.GetLoggingService() != s.scope.GCPManagedControlPlane.Spec.LoggingService.String() {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredLoggingService: s.scope.GCPManagedControlPlane.Spec.LoggingService.String()})
 		log.V(2).Info("LoggingService config update required", "current", existingCluster.GetLoggingService(), "desired", s.scope.GCPManagedControlPlane.Spec.LoggingService.String())
 	}
 
 	// MonitoringService
-	if existingCluster.GetMonitoringService() != s.scope.GCPManagedControlPlane.Spec.MonitoringService.String() {
-		needUpdate = true
-		clusterUpdate.DesiredLoggingService = s.scope.GCPManagedControlPlane.Spec.MonitoringService.String()
+	if s.scope.GCPManagedControlPlane.Spec.MonitoringService != nil && existingCluster.GetMonitoringService() != s.scope.GCPManagedControlPlane.Spec.MonitoringService.String() {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredMonitoringService: s.scope.GCPManagedControlPlane.Spec.MonitoringService.String()})
 		log.V(2).Info("MonitoringService config update required", "current", existingCluster.GetMonitoringService(), "desired", s.scope.GCPManagedControlPlane.Spec.MonitoringService.String())
 	}
 
+	// LoggingConfig
+	desiredLoggingConfig := convertToSdkLoggingConfig(s.scope.GCPManagedControlPlane.Spec.LoggingConfig)
+	if !compareLoggingConfig(desiredLoggingConfig, existingCluster.GetLoggingConfig()) {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredLoggingConfig: desiredLoggingConfig})
+		log.V(2).Info("Logging config update required", "current", existingCluster.GetLoggingConfig(), "desired", desiredLoggingConfig)
+	}
+
+	// MonitoringConfig
+	desiredMonitoringConfig := convertToSdkMonitoringConfig(s.scope.GCPManagedControlPlane.Spec.MonitoringConfig)
+	if !compareMonitoringConfig(desiredMonitoringConfig, existingCluster.GetMonitoringConfig()) {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredMonitoringConfig: desiredMonitoringConfig})
+		log.V(2).Info("Monitoring config update required", "current", existingCluster.GetMonitoringConfig(), "desired", desiredMonitoringConfig)
+	}
+
+	// ResourceUsageExportConfig
+	desiredResourceUsageExportConfig := convertToSdkResourceUsageExportConfig(s.scope.GCPManagedControlPlane.Spec.ResourceUsageExport)
+	if !compareResourceUsageExportConfig(desiredResourceUsageExportConfig, existingCluster.GetResourceUsageExportConfig()) {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredResourceUsageExportConfig: desiredResourceUsageExportConfig})
+		log.V(2).Info("Resource usage export config update required", "current", existingCluster.GetResourceUsageExportConfig(), "desired", desiredResourceUsageExportConfig)
+	}
+
+	// CostManagementConfig
+	desiredCostManagementConfig := convertToSdkCostManagementConfig(s.scope.GCPManagedControlPlane.Spec.EnableCostAllocation)
+	if !compareCostManagementConfig(desiredCostManagementConfig, existingCluster.GetCostManagementConfig()) {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredCostManagementConfig: desiredCostManagementConfig})
+		log.V(2).Info("Cost management config update required", "current", existingCluster.GetCostManagementConfig(), "desired", desiredCostManagementConfig)
+	}
+
+	// WorkloadIdentityConfig
+	// Autopilot clusters always have Workload Identity enabled, so it is only reconciled when explicitly set.
+	if s.scope.GCPManagedControlPlane.Spec.WorkloadIdentityConfig != nil || !s.scope.IsAutopilotCluster() {
+		desiredWorkloadIdentityConfig := convertToSdkWorkloadIdentityConfig(s.scope.GCPManagedControlPlane.Spec.WorkloadIdentityConfig)
+		if desiredWorkloadIdentityConfig.GetWorkloadPool() != existingCluster.GetWorkloadIdentityConfig().GetWorkloadPool() {
+			updates = append(updates, &containerpb.ClusterUpdate{DesiredWorkloadIdentityConfig: desiredWorkloadIdentityConfig})
+			log.V(2).Info("Workload identity config update required", "current", existingCluster.GetWorkloadIdentityConfig().GetWorkloadPool(), "desired", desiredWorkloadIdentityConfig.GetWorkloadPool())
+		}
+	}
+
+	// AuthenticatorGroupsConfig
+	desiredAuthenticatorGroupsConfig := convertToSdkAuthenticatorGroupsConfig(s.scope.GCPManagedControlPlane.Spec.AuthenticatorGroupConfig)
+	if desiredAuthenticatorGroupsConfig.GetEnabled() != existingCluster.GetAuthenticatorGroupsConfig().GetEnabled() ||
+		desiredAuthenticatorGroupsConfig.GetSecurityGroup() != existingCluster.GetAuthenticatorGroupsConfig().GetSecurityGroup() {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredAuthenticatorGroupsConfig: desiredAuthenticatorGroupsConfig})
+		log.V(2).Info("Authenticator groups config update required", "current", existingCluster.GetAuthenticatorGroupsConfig().GetSecurityGroup(), "desired", desiredAuthenticatorGroupsConfig.GetSecurityGroup())
+	}
+
+	// DNSConfig
+	if cn := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork; cn != nil && cn.DNSConfig != nil {
+		desiredDNSConfig := convertToSdkDNSConfig(cn.DNSConfig)
+		existingDNSConfig := existingCluster.GetNetworkConfig().GetDnsConfig()
+		if desiredDNSConfig.GetClusterDns() != existingDNSConfig.GetClusterDns() ||
+			desiredDNSConfig.GetClusterDnsScope() != existingDNSConfig.GetClusterDnsScope() ||
+			desiredDNSConfig.GetClusterDnsDomain() != existingDNSConfig.GetClusterDnsDomain() {
+			updates = append(updates, &containerpb.ClusterUpdate{DesiredDnsConfig: desiredDNSConfig})
+			log.V(2).Info("DNS config update required", "current", existingDNSConfig, "desired", desiredDNSConfig)
+		}
+	}
+
+	// IntraNodeVisibility
+	desiredIntraNodeVisibility := s.scope.GCPManagedControlPlane.Spec.ClusterNetwork != nil && s.scope.GCPManagedControlPlane.Spec.ClusterNetwork.EnableIntraNodeVisibility
+	if desiredIntraNodeVisibility != existingCluster.GetNetworkConfig().GetEnableIntraNodeVisibility() {
+		updates = append(updates, &containerpb.ClusterUpdate{
+			DesiredIntraNodeVisibilityConfig: &containerpb.IntraNodeVisibilityConfig{
+				Enabled: desiredIntraNodeVisibility,
+			},
+		})
+		log.V(2).Info("Intra node visibility update required", "current", existingCluster.GetNetworkConfig().GetEnableIntraNodeVisibility(), "desired", desiredIntraNodeVisibility)
+	}
+
+	// AddonsConfig
+	desiredAddonsConfig := convertToSdkAddonsConfig(s.scope.GCPManagedControlPlane.Spec.AddonsConfig)
+	if !compareAddonsConfig(desiredAddonsConfig, existingCluster.GetAddonsConfig()) {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredAddonsConfig: desiredAddonsConfig})
+		log.V(2).Info("Addons config update required", "current", existingCluster.GetAddonsConfig(), "desired", desiredAddonsConfig)
+	}
+
+	// Secret Manager
+	if desiredSecretManagerConfig := convertToSdkSecretManagerConfig(s.scope.GCPManagedControlPlane.Spec.AddonsConfig); desiredSecretManagerConfig != nil &&
+		desiredSecretManagerConfig.GetEnabled() != existingCluster.GetSecretManagerConfig().GetEnabled() {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredSecretManagerConfig: desiredSecretManagerConfig})
+		log.V(2).Info("Secret Manager config update required", "current", existingCluster.GetSecretManagerConfig(), "desired", desiredSecretManagerConfig)
+	}
+
+	// Autopilot
+	if autopilot := s.scope.GCPManagedControlPlane.Spec.Autopilot; autopilot != nil {
+		desiredAutopilot := convertToSdkAutopilot(true, autopilot)
+		if desiredAutopilot.GetWorkloadPolicyConfig() != nil &&
+			desiredAutopilot.GetWorkloadPolicyConfig().GetAllowNetAdmin() != existingCluster.GetAutopilot().GetWorkloadPolicyConfig().GetAllowNetAdmin() {
+			updates = append(updates, &containerpb.ClusterUpdate{DesiredAutopilotWorkloadPolicyConfig: desiredAutopilot.GetWorkloadPolicyConfig()})
+			log.V(2).Info("Autopilot workload policy update required", "current", existingCluster.GetAutopilot().GetWorkloadPolicyConfig(), "desired", desiredAutopilot.GetWorkloadPolicyConfig())
+		}
+
+		existingTags := existingCluster.GetNodePoolAutoConfig().GetResourceManagerTags().GetTags()
+		if !cmp.Equal(autopilot.ResourceManagerTags, existingTags, cmpopts.EquateEmpty()) {
+			updates = append(updates, &containerpb.ClusterUpdate{
+				DesiredNodePoolAutoConfigResourceManagerTags: &containerpb.ResourceManagerTags{
+					Tags: autopilot.ResourceManagerTags,
+				},
+			})
+			log.V(2).Info("Autopilot resource manager tags update required", "current", existingTags, "desired", autopilot.ResourceManagerTags)
+		}
+	}
+
+	// ClusterAutoscaling
+	desiredClusterAutoscaling := convertToSdkClusterAutoscaling(s.scope.GCPManagedControlPlane.Spec.ClusterAutoscaling)
+	if !compareClusterAutoscaling(desiredClusterAutoscaling, existingCluster.GetAutoscaling()) {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredClusterAutoscaling: desiredClusterAutoscaling})
+		log.V(2).Info("Cluster autoscaling update required", "current", existingCluster.GetAutoscaling(), "desired", desiredClusterAutoscaling)
+	}
+
+	// Security posture
+	securityUpdates, securityMismatches := s.diffSecurityPosture(existingCluster)
+	if len(securityUpdates) > 0 {
+		updates = append(updates, securityUpdates...)
+		log.V(2).Info("Security settings update required", "mismatches", securityMismatches)
+	}
+
 	// DesiredMasterAuthorizedNetworksConfig
 	// When desiredMasterAuthorizedNetworksConfig is nil, it means that the user wants to disable the feature.
+	// The authorized networks and the DNS endpoint are both part of the control plane endpoints config, which is
+	// a single update.
+	var desiredControlPlaneEndpointsConfig *containerpb.ControlPlaneEndpointsConfig
 	desiredMasterAuthorizedNetworksConfig := convertToSdkMasterAuthorizedNetworksConfig(s.scope.GCPManagedControlPlane.Spec.MasterAuthorizedNetworksConfig)
 	if !compareMasterAuthorizedNetworksConfig(desiredMasterAuthorizedNetworksConfig, existingCluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetAuthorizedNetworksConfig()) {
-		needUpdate = true
-		clusterUpdate.DesiredControlPlaneEndpointsConfig.IpEndpointsConfig.AuthorizedNetworksConfig = desiredMasterAuthorizedNetworksConfig
+		desiredControlPlaneEndpointsConfig = &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				AuthorizedNetworksConfig: desiredMasterAuthorizedNetworksConfig,
+			},
+		}
 		log.V(2).Info("Master authorized networks config update required", "current", existingCluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetAuthorizedNetworksConfig(), "desired", desiredMasterAuthorizedNetworksConfig)
 	}
 	log.V(4).Info("Master authorized networks config update check", "current", existingCluster.GetControlPlaneEndpointsConfig().GetIpEndpointsConfig().GetAuthorizedNetworksConfig())
@@ -471,16 +833,38 @@ func (s *Service) checkDiffAndPrepareUpdate(existingCluster *containerpb.Cluster
 		log.V(4).Info("Master authorized networks config update check", "desired", desiredMasterAuthorizedNetworksConfig)
 	}
 
+	// DNS endpoint
+	desiredDNSEndpointConfig := convertToSdkDNSEndpointConfig(s.scope.GCPManagedControlPlane.Spec.DNSEndpoint)
+	if desiredDNSEndpointConfig.GetAllowExternalTraffic() != existingCluster.GetControlPlaneEndpointsConfig().GetDnsEndpointConfig().GetAllowExternalTraffic() {
+		if desiredControlPlaneEndpointsConfig == nil {
+			desiredControlPlaneEndpointsConfig = &containerpb.ControlPlaneEndpointsConfig{}
+		}
+		desiredControlPlaneEndpointsConfig.DnsEndpointConfig = desiredDNSEndpointConfig
+		log.V(2).Info("DNS endpoint config update required", "current", existingCluster.GetControlPlaneEndpointsConfig().GetDnsEndpointConfig(), "desired", desiredDNSEndpointConfig)
+	}
+	if desiredControlPlaneEndpointsConfig != nil {
+		updates = append(updates, &containerpb.ClusterUpdate{DesiredControlPlaneEndpointsConfig: desiredControlPlaneEndpointsConfig})
+	}
+
+	if len(updates) == 0 {
+		return false, nil
+	}
+
+	// GKE applies a single setting per update, the remaining ones are sent in the next reconciliations.
 	updateClusterRequest := containerpb.UpdateClusterRequest{
 		Name:   s.scope.ClusterFullName(),
-		Update: &clusterUpdate,
+		Update: updates[0],
 	}
-	log.V(4).Info("Update cluster request. ", "needUpdate", needUpdate, "updateClusterRequest", &updateClusterRequest)
-	return needUpdate, &updateClusterRequest
+	log.V(4).Info("Update cluster request. ", "pendingUpdates", len(updates), "updateClusterRequest", &updateClusterRequest)
+	return true, &updateClusterRequest
 }
 
 // compare if two MasterAuthorizedNetworksConfig are equal.
 func compareMasterAuthorizedNetworksConfig(a, b *containerpb.MasterAuthorizedNetworksConfig) bool {
+	// Disabled configs are equal, whether GKE reports them or not.
+	if !a.GetEnabled() && !b.GetEnabled() {
+		return true
+	}
 	if a == nil && b == nil {
 		return true
 	}
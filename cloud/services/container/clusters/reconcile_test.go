@@ -0,0 +1,200 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+// newUnchangedCluster returns a GKE cluster matching an empty GCPManagedControlPlane spec.
+func newUnchangedCluster() *containerpb.Cluster {
+	return &containerpb.Cluster{
+		ControlPlaneEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig{
+			IpEndpointsConfig: &containerpb.ControlPlaneEndpointsConfig_IPEndpointsConfig{
+				AuthorizedNetworksConfig: &containerpb.MasterAuthorizedNetworksConfig{
+					GcpPublicCidrsAccessEnabled: ptr.To(false),
+				},
+			},
+		},
+	}
+}
+
+// withWorkloadPool returns the cluster with Workload Identity enabled for the given workload pool.
+func withWorkloadPool(cluster *containerpb.Cluster, workloadPool string) *containerpb.Cluster {
+	cluster.WorkloadIdentityConfig = &containerpb.WorkloadIdentityConfig{WorkloadPool: workloadPool}
+	return cluster
+}
+
+// withAuthenticatorGroups returns the cluster with Google Groups for RBAC enabled for the given security group.
+func withAuthenticatorGroups(cluster *containerpb.Cluster, securityGroup string) *containerpb.Cluster {
+	cluster.AuthenticatorGroupsConfig = &containerpb.AuthenticatorGroupsConfig{Enabled: true, SecurityGroup: securityGroup}
+	return cluster
+}
+
+func TestCheckDiffAndPrepareUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       infrav1exp.GCPManagedControlPlaneSpec
+		cluster    *containerpb.Cluster
+		wantUpdate *containerpb.ClusterUpdate
+	}{
+		{
+			name:    "no changes",
+			spec:    infrav1exp.GCPManagedControlPlaneSpec{},
+			cluster: newUnchangedCluster(),
+		},
+		{
+			name: "several changes are sent one at a time",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				ReleaseChannel:      ptr.To(infrav1exp.Stable),
+				LoggingService:      ptr.To(infrav1exp.LoggingService("none")),
+				EnableShieldedNodes: ptr.To(true),
+			},
+			cluster: newUnchangedCluster(),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredReleaseChannel: &containerpb.ReleaseChannel{Channel: containerpb.ReleaseChannel_STABLE},
+			},
+		},
+		{
+			name: "security settings are sent one at a time",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				EnableShieldedNodes: ptr.To(true),
+				SecurityPosture:     &infrav1exp.SecurityPosture{Mode: ptr.To(infrav1exp.SecurityPostureBasic)},
+			},
+			cluster: newUnchangedCluster(),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredShieldedNodes: &containerpb.ShieldedNodes{Enabled: true},
+			},
+		},
+		{
+			name: "workload identity is enabled",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				WorkloadIdentityConfig: &infrav1exp.WorkloadIdentityConfig{WorkloadPool: "my-project.svc.id.goog"},
+			},
+			cluster: newUnchangedCluster(),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredWorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{WorkloadPool: "my-project.svc.id.goog"},
+			},
+		},
+		{
+			name: "workload pool is changed",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				WorkloadIdentityConfig: &infrav1exp.WorkloadIdentityConfig{WorkloadPool: "my-project.svc.id.goog"},
+			},
+			cluster: withWorkloadPool(newUnchangedCluster(), "other-project.svc.id.goog"),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredWorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{WorkloadPool: "my-project.svc.id.goog"},
+			},
+		},
+		{
+			name:    "workload identity is disabled",
+			spec:    infrav1exp.GCPManagedControlPlaneSpec{},
+			cluster: withWorkloadPool(newUnchangedCluster(), "my-project.svc.id.goog"),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredWorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{},
+			},
+		},
+		{
+			name: "workload identity of autopilot clusters is kept when not set",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				EnableAutopilot: true,
+			},
+			cluster: withWorkloadPool(newUnchangedCluster(), "my-project.svc.id.goog"),
+		},
+		{
+			name: "authenticator groups are enabled",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				AuthenticatorGroupConfig: &infrav1exp.AuthenticatorGroupConfig{SecurityGroups: "gke-security-groups@example.com"},
+			},
+			cluster: newUnchangedCluster(),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredAuthenticatorGroupsConfig: &containerpb.AuthenticatorGroupsConfig{Enabled: true, SecurityGroup: "gke-security-groups@example.com"},
+			},
+		},
+		{
+			name: "authenticator security group is changed",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				AuthenticatorGroupConfig: &infrav1exp.AuthenticatorGroupConfig{SecurityGroups: "gke-security-groups@example.com"},
+			},
+			cluster: withAuthenticatorGroups(newUnchangedCluster(), "gke-security-groups@example.org"),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredAuthenticatorGroupsConfig: &containerpb.AuthenticatorGroupsConfig{Enabled: true, SecurityGroup: "gke-security-groups@example.com"},
+			},
+		},
+		{
+			name:    "authenticator groups are disabled",
+			spec:    infrav1exp.GCPManagedControlPlaneSpec{},
+			cluster: withAuthenticatorGroups(newUnchangedCluster(), "gke-security-groups@example.com"),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredAuthenticatorGroupsConfig: &containerpb.AuthenticatorGroupsConfig{},
+			},
+		},
+		{
+			name: "workload identity is updated before authenticator groups",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				WorkloadIdentityConfig:   &infrav1exp.WorkloadIdentityConfig{WorkloadPool: "my-project.svc.id.goog"},
+				AuthenticatorGroupConfig: &infrav1exp.AuthenticatorGroupConfig{SecurityGroups: "gke-security-groups@example.com"},
+			},
+			cluster: newUnchangedCluster(),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredWorkloadIdentityConfig: &containerpb.WorkloadIdentityConfig{WorkloadPool: "my-project.svc.id.goog"},
+			},
+		},
+		{
+			name: "authenticator groups are updated once workload identity matches",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				WorkloadIdentityConfig:   &infrav1exp.WorkloadIdentityConfig{WorkloadPool: "my-project.svc.id.goog"},
+				AuthenticatorGroupConfig: &infrav1exp.AuthenticatorGroupConfig{SecurityGroups: "gke-security-groups@example.com"},
+			},
+			cluster: withWorkloadPool(newUnchangedCluster(), "my-project.svc.id.goog"),
+			wantUpdate: &containerpb.ClusterUpdate{
+				DesiredAuthenticatorGroupsConfig: &containerpb.AuthenticatorGroupsConfig{Enabled: true, SecurityGroup: "gke-security-groups@example.com"},
+			},
+		},
+		{
+			name: "workload identity and authenticator groups match",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				WorkloadIdentityConfig:   &infrav1exp.WorkloadIdentityConfig{WorkloadPool: "my-project.svc.id.goog"},
+				AuthenticatorGroupConfig: &infrav1exp.AuthenticatorGroupConfig{SecurityGroups: "gke-security-groups@example.com"},
+			},
+			cluster: withAuthenticatorGroups(withWorkloadPool(newUnchangedCluster(), "my-project.svc.id.goog"), "gke-security-groups@example.com"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(&scope.ManagedControlPlaneScope{
+				GCPManagedControlPlane: &infrav1exp.GCPManagedControlPlane{Spec: tt.spec},
+			})
+			log := logr.Discard()
+			needUpdate, request := s.checkDiffAndPrepareUpdate(tt.cluster, false, &log)
+			if needUpdate != (tt.wantUpdate != nil) {
+				t.Fatalf("checkDiffAndPrepareUpdate() needUpdate = %t, want %t: %v", needUpdate, tt.wantUpdate != nil, request.GetUpdate())
+			}
+			if d := cmp.Diff(tt.wantUpdate, request.GetUpdate(), protocmp.Transform()); d != "" {
+				t.Errorf("checkDiffAndPrepareUpdate() update mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+}
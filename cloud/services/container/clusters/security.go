@@ -0,0 +1,159 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+// convertToSdkBinaryAuthorization converts the BinaryAuthorization defined in CRs to the SDK version.
+func convertToSdkBinaryAuthorization(config *infrav1exp.BinaryAuthorization) *containerpb.BinaryAuthorization {
+	if config == nil {
+		return nil
+	}
+
+	switch config.EvaluationMode {
+	case infrav1exp.BinaryAuthorizationProjectSingletonPolicyEnforce:
+		return &containerpb.BinaryAuthorization{EvaluationMode: containerpb.BinaryAuthorization_PROJECT_SINGLETON_POLICY_ENFORCE}
+	default:
+		return &containerpb.BinaryAuthorization{EvaluationMode: containerpb.BinaryAuthorization_DISABLED}
+	}
+}
+
+// convertToSdkShieldedNodes converts the shielded nodes setting defined in CRs to the SDK version.
+func convertToSdkShieldedNodes(enabled *bool) *containerpb.ShieldedNodes {
+	if enabled == nil {
+		return nil
+	}
+
+	return &containerpb.ShieldedNodes{Enabled: *enabled}
+}
+
+// convertToSdkSecurityPostureConfig converts the SecurityPosture defined in CRs to the SDK version.
+func convertToSdkSecurityPostureConfig(config *infrav1exp.SecurityPosture) *containerpb.SecurityPostureConfig {
+	if config == nil {
+		return nil
+	}
+
+	sdkConfig := &containerpb.SecurityPostureConfig{}
+	if config.Mode != nil {
+		mode := containerpb.SecurityPostureConfig_DISABLED
+		switch *config.Mode {
+		case infrav1exp.SecurityPostureBasic:
+			mode = containerpb.SecurityPostureConfig_BASIC
+		case infrav1exp.SecurityPostureEnterprise:
+			mode = containerpb.SecurityPostureConfig_ENTERPRISE
+		}
+		sdkConfig.Mode = &mode
+	}
+	if config.VulnerabilityMode != nil {
+		vulnerabilityMode := containerpb.SecurityPostureConfig_VULNERABILITY_DISABLED
+		switch *config.VulnerabilityMode {
+		case infrav1exp.SecurityPostureBasic:
+			vulnerabilityMode = containerpb.SecurityPostureConfig_VULNERABILITY_BASIC
+		case infrav1exp.SecurityPostureEnterprise:
+			vulnerabilityMode = containerpb.SecurityPostureConfig_VULNERABILITY_ENTERPRISE
+		}
+		sdkConfig.VulnerabilityMode = &vulnerabilityMode
+	}
+
+	return sdkConfig
+}
+
+// convertToSdkDatabaseEncryption converts the DatabaseEncryption defined in CRs to the SDK version.
+func convertToSdkDatabaseEncryption(config *infrav1exp.DatabaseEncryption) *containerpb.DatabaseEncryption {
+	if config == nil {
+		return nil
+	}
+
+	if config.KeyName == "" {
+		return &containerpb.DatabaseEncryption{State: containerpb.DatabaseEncryption_DECRYPTED}
+	}
+
+	return &containerpb.DatabaseEncryption{
+		KeyName: config.KeyName,
+		State:   containerpb.DatabaseEncryption_ENCRYPTED,
+	}
+}
+
+// diffSecurityPosture compares the security settings of the spec with the live cluster. It returns an update
+// for every setting that differs and a description of every mismatch. Settings that are not specified are
+// not compared.
+func (s *Service) diffSecurityPosture(existingCluster *containerpb.Cluster) ([]*containerpb.ClusterUpdate, []string) {
+	spec := s.scope.GCPManagedControlPlane.Spec
+	var updates []*containerpb.ClusterUpdate
+	var mismatches []string
+
+	if desired := convertToSdkBinaryAuthorization(spec.BinaryAuthorization); desired != nil {
+		existing := existingCluster.GetBinaryAuthorization().GetEvaluationMode()
+		// Clusters created with the deprecated enabled flag report no evaluation mode.
+		if existing == containerpb.BinaryAuthorization_EVALUATION_MODE_UNSPECIFIED && !existingCluster.GetBinaryAuthorization().GetEnabled() {
+			existing = containerpb.BinaryAuthorization_DISABLED
+		}
+		if desired.GetEvaluationMode() != existing {
+			updates = append(updates, &containerpb.ClusterUpdate{DesiredBinaryAuthorization: desired})
+			mismatches = append(mismatches, fmt.Sprintf("binary authorization is %s, expected %s", existing, desired.GetEvaluationMode()))
+		}
+	}
+
+	if desired := convertToSdkShieldedNodes(spec.EnableShieldedNodes); desired != nil {
+		if desired.GetEnabled() != existingCluster.GetShieldedNodes().GetEnabled() {
+			updates = append(updates, &containerpb.ClusterUpdate{DesiredShieldedNodes: desired})
+			mismatches = append(mismatches, fmt.Sprintf("shielded nodes enabled is %t, expected %t", existingCluster.GetShieldedNodes().GetEnabled(), desired.GetEnabled()))
+		}
+	}
+
+	if desired := convertToSdkSecurityPostureConfig(spec.SecurityPosture); desired != nil {
+		existing := existingCluster.GetSecurityPostureConfig()
+		var postureMismatches []string
+		if desired.Mode != nil && desired.GetMode() != existing.GetMode() {
+			postureMismatches = append(postureMismatches, fmt.Sprintf("security posture mode is %s, expected %s", existing.GetMode(), desired.GetMode()))
+		}
+		if desired.VulnerabilityMode != nil && desired.GetVulnerabilityMode() != existing.GetVulnerabilityMode() {
+			postureMismatches = append(postureMismatches, fmt.Sprintf("vulnerability scanning mode is %s, expected %s", existing.GetVulnerabilityMode(), desired.GetVulnerabilityMode()))
+		}
+		if len(postureMismatches) > 0 {
+			updates = append(updates, &containerpb.ClusterUpdate{DesiredSecurityPostureConfig: desired})
+			mismatches = append(mismatches, postureMismatches...)
+		}
+	}
+
+	if desired := convertToSdkDatabaseEncryption(spec.DatabaseEncryption); desired != nil {
+		existing := existingCluster.GetDatabaseEncryption()
+		existingState := existing.GetState()
+		if existingState == containerpb.DatabaseEncryption_UNKNOWN {
+			existingState = containerpb.DatabaseEncryption_DECRYPTED
+		}
+		switch {
+		case desired.GetState() != existingState || desired.GetKeyName() != existing.GetKeyName():
+			updates = append(updates, &containerpb.ClusterUpdate{DesiredDatabaseEncryption: desired})
+			mismatches = append(mismatches, fmt.Sprintf("database encryption is %s with key %q, expected %s with key %q", existingState, existing.GetKeyName(), desired.GetState(), desired.GetKeyName()))
+		case existing.GetCurrentState() == containerpb.DatabaseEncryption_CURRENT_STATE_ENCRYPTION_ERROR ||
+			existing.GetCurrentState() == containerpb.DatabaseEncryption_CURRENT_STATE_DECRYPTION_ERROR:
+			msg := fmt.Sprintf("database encryption is in state %s", existing.GetCurrentState())
+			if errs := existing.GetLastOperationErrors(); len(errs) > 0 {
+				msg = fmt.Sprintf("%s: %s", msg, errs[len(errs)-1].GetErrorMessage())
+			}
+			mismatches = append(mismatches, msg)
+		}
+	}
+
+	return updates, mismatches
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestDiffSecurityPosture(t *testing.T) {
+	const keyName = "projects/my-project/locations/us-central1/keyRings/gke/cryptoKeys/etcd"
+
+	tests := []struct {
+		name           string
+		spec           infrav1exp.GCPManagedControlPlaneSpec
+		cluster        *containerpb.Cluster
+		wantUpdates    []*containerpb.ClusterUpdate
+		wantMismatches int
+	}{
+		{
+			name:           "nothing specified",
+			spec:           infrav1exp.GCPManagedControlPlaneSpec{},
+			cluster:        &containerpb.Cluster{ShieldedNodes: &containerpb.ShieldedNodes{Enabled: true}},
+			wantMismatches: 0,
+		},
+		{
+			name: "settings match",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				BinaryAuthorization: &infrav1exp.BinaryAuthorization{EvaluationMode: infrav1exp.BinaryAuthorizationDisabled},
+				EnableShieldedNodes: ptr.To(true),
+				DatabaseEncryption:  &infrav1exp.DatabaseEncryption{KeyName: keyName},
+			},
+			cluster: &containerpb.Cluster{
+				ShieldedNodes: &containerpb.ShieldedNodes{Enabled: true},
+				DatabaseEncryption: &containerpb.DatabaseEncryption{
+					KeyName: keyName,
+					State:   containerpb.DatabaseEncryption_ENCRYPTED,
+				},
+			},
+			wantMismatches: 0,
+		},
+		{
+			name: "binary authorization and encryption differ",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				BinaryAuthorization: &infrav1exp.BinaryAuthorization{EvaluationMode: infrav1exp.BinaryAuthorizationProjectSingletonPolicyEnforce},
+				DatabaseEncryption:  &infrav1exp.DatabaseEncryption{KeyName: keyName},
+			},
+			cluster: &containerpb.Cluster{},
+			wantUpdates: []*containerpb.ClusterUpdate{
+				{
+					DesiredBinaryAuthorization: &containerpb.BinaryAuthorization{
+						EvaluationMode: containerpb.BinaryAuthorization_PROJECT_SINGLETON_POLICY_ENFORCE,
+					},
+				},
+				{
+					DesiredDatabaseEncryption: &containerpb.DatabaseEncryption{
+						KeyName: keyName,
+						State:   containerpb.DatabaseEncryption_ENCRYPTED,
+					},
+				},
+			},
+			wantMismatches: 2,
+		},
+		{
+			name: "encryption failed",
+			spec: infrav1exp.GCPManagedControlPlaneSpec{
+				DatabaseEncryption: &infrav1exp.DatabaseEncryption{KeyName: keyName},
+			},
+			cluster: &containerpb.Cluster{
+				DatabaseEncryption: &containerpb.DatabaseEncryption{
+					KeyName:      keyName,
+					State:        containerpb.DatabaseEncryption_ENCRYPTED,
+					CurrentState: containerpb.DatabaseEncryption_CURRENT_STATE_ENCRYPTION_ERROR.Enum(),
+				},
+			},
+			wantMismatches: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(&scope.ManagedControlPlaneScope{
+				GCPManagedControlPlane: &infrav1exp.GCPManagedControlPlane{Spec: tt.spec},
+			})
+			gotUpdates, gotMismatches := s.diffSecurityPosture(tt.cluster)
+			if d := cmp.Diff(tt.wantUpdates, gotUpdates, protocmp.Transform()); d != "" {
+				t.Errorf("diffSecurityPosture() updates mismatch (-want +got):\n%s", d)
+			}
+			if len(gotMismatches) != tt.wantMismatches {
+				t.Errorf("diffSecurityPosture() mismatches = %v, want %d", gotMismatches, tt.wantMismatches)
+			}
+		})
+	}
+}
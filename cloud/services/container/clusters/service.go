@@ -24,6 +24,8 @@ import (
 // Service implements clusters reconciler.
 type Service struct {
 	scope *scope.ManagedControlPlaneScope
+	// serverConfigCache holds the GKE server configs across reconciles.
+	serverConfigCache *serverConfigCache
 }
 
 var _ cloud.ReconcilerWithResult = &Service{}
@@ -31,6 +33,7 @@ var _ cloud.ReconcilerWithResult = &Service{}
 // New returns Service from given scope.
 func New(scope *scope.ManagedControlPlaneScope) *Service {
 	return &Service{
-		scope: scope,
+		scope:             scope,
+		serverConfigCache: defaultServerConfigCache,
 	}
 }
@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// kubeconfigServiceAccountName is the name of the Kubernetes service account and cluster role binding
+	// created in the GKE cluster for the ServiceAccountToken kubeconfig auth mode.
+	kubeconfigServiceAccountName = "cluster-api-provider-gcp"
+	// kubeconfigServiceAccountNamespace is the namespace of the Kubernetes service account.
+	kubeconfigServiceAccountNamespace = metav1.NamespaceSystem
+)
+
+// generateServiceAccountToken ensures the GKE cluster has a cluster admin service account for Cluster API and
+// requests a token for it. It returns the token with its expiry.
+func (s *Service) generateServiceAccountToken(ctx context.Context, cluster *containerpb.Cluster) (string, time.Time, error) {
+	clientset, err := s.workloadClientset(ctx, cluster)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kubeconfigServiceAccountName,
+			Namespace: kubeconfigServiceAccountNamespace,
+		},
+	}
+	if _, err := clientset.CoreV1().ServiceAccounts(kubeconfigServiceAccountNamespace).Create(ctx, serviceAccount, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", time.Time{}, fmt.Errorf("creating service account %s/%s: %w", kubeconfigServiceAccountNamespace, kubeconfigServiceAccountName, err)
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: kubeconfigServiceAccountName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      kubeconfigServiceAccountName,
+				Namespace: kubeconfigServiceAccountNamespace,
+			},
+		},
+	}
+	if _, err := clientset.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", time.Time{}, fmt.Errorf("creating cluster role binding %s: %w", kubeconfigServiceAccountName, err)
+	}
+
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: ptr.To(int64(s.serviceAccountTokenTTL().Seconds())),
+		},
+	}
+	tokenRequest, err = clientset.CoreV1().ServiceAccounts(kubeconfigServiceAccountNamespace).CreateToken(ctx, kubeconfigServiceAccountName, tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("requesting token for service account %s/%s: %w", kubeconfigServiceAccountNamespace, kubeconfigServiceAccountName, err)
+	}
+
+	return tokenRequest.Status.Token, tokenRequest.Status.ExpirationTimestamp.Time, nil
+}
+
+// workloadClientset returns a clientset for the GKE cluster authenticated with an access token of the
+// controller service account.
+func (s *Service) workloadClientset(ctx context.Context, cluster *containerpb.Cluster) (kubernetes.Interface, error) {
+	host, certData, err := controlPlaneEndpoint(cluster, s.scope.GCPManagedControlPlane.Spec.EndpointType)
+	if err != nil {
+		return nil, err
+	}
+
+	token, _, err := s.generateToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{
+		Host:        "https://" + host,
+		BearerToken: token,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: certData,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating clientset for GKE cluster: %w", err)
+	}
+
+	return clientset, nil
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/version"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// maxNodeVersionSkew is the number of minor versions GKE supports nodes to be older than the control plane.
+	maxNodeVersionSkew = 2
+	// serverConfigTTL is how long the GKE server config of a location is cached.
+	serverConfigTTL = 10 * time.Minute
+)
+
+// nodePoolVersion is the version of a node pool, checked against the version of the control plane.
+type nodePoolVersion struct {
+	name    string
+	version string
+}
+
+// reconcileVersion validates the desired control plane version against the GKE server config and the versions of
+// the node pools, and reports the versions the control plane can be upgraded to in status. It returns true when the
+// upgrade to the desired version is blocked. The version is only validated while an upgrade is pending, and failing
+// to get the server config otherwise leaves the allowed versions to be refreshed by a later reconcile.
+func (s *Service) reconcileVersion(ctx context.Context, cluster *containerpb.Cluster, log *logr.Logger) (bool, error) {
+	current := convertToSdkMasterVersion(cluster.GetCurrentMasterVersion())
+	desired := current
+	if s.scope.GCPManagedControlPlane.Spec.ControlPlaneVersion != nil {
+		desired = convertToSdkMasterVersion(*s.scope.GCPManagedControlPlane.Spec.ControlPlaneVersion)
+	}
+	upgradePending := desired != current
+
+	serverConfig, err := s.serverConfig(ctx)
+	if err != nil {
+		if upgradePending {
+			log.Error(err, "Error getting GKE server config", "location", s.scope.ClusterLocation())
+			return false, err
+		}
+		log.V(2).Info("Unable to refresh the allowed control plane versions", "location", s.scope.ClusterLocation(), "error", err.Error())
+		conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneVersionValidCondition)
+		return false, nil
+	}
+	validVersions := validMasterVersions(serverConfig, cluster.GetReleaseChannel().GetChannel())
+	nodeVersions := s.clusterNodePoolVersions(cluster)
+	if upgradePending {
+		machinePoolVersions, err := s.machinePoolVersions(ctx)
+		if err != nil {
+			return false, err
+		}
+		nodeVersions = append(nodeVersions, machinePoolVersions...)
+	}
+	s.scope.GCPManagedControlPlane.Status.AllowedVersions = allowedMasterVersions(validVersions, current, nodeVersions)
+
+	if !upgradePending {
+		conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneVersionValidCondition)
+		return false, nil
+	}
+
+	if reason, err := validateMasterVersion(desired, current, validVersions, nodeVersions); err != nil {
+		log.Info("Control plane upgrade blocked", "current", current, "desired", desired, "reason", err.Error())
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneVersionValidCondition, reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return true, nil
+	}
+	conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEControlPlaneVersionValidCondition)
+
+	return false, nil
+}
+
+// clusterNodePoolVersions returns the versions of the node pools of the cluster. Autopilot nodes are upgraded by GKE
+// after the control plane and are not returned.
+func (s *Service) clusterNodePoolVersions(cluster *containerpb.Cluster) []nodePoolVersion {
+	if s.scope.IsAutopilotCluster() {
+		return nil
+	}
+
+	var versions []nodePoolVersion
+	for _, nodePool := range cluster.GetNodePools() {
+		versions = append(versions, nodePoolVersion{name: nodePool.GetName(), version: nodePool.GetVersion()})
+	}
+
+	return versions
+}
+
+// machinePoolVersions returns the versions the GCPManagedMachinePools of the cluster are being upgraded to, which
+// are only looked up while a control plane upgrade is pending.
+func (s *Service) machinePoolVersions(ctx context.Context) ([]nodePoolVersion, error) {
+	if s.scope.IsAutopilotCluster() {
+		return nil, nil
+	}
+
+	_, machinePools, err := s.scope.GetAllNodePools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var versions []nodePoolVersion
+	for _, machinePool := range machinePools {
+		if machinePool.Spec.Template.Spec.Version == nil {
+			continue
+		}
+		versions = append(versions, nodePoolVersion{
+			name:    machinePool.Spec.Template.Spec.InfrastructureRef.Name,
+			version: *machinePool.Spec.Template.Spec.Version,
+		})
+	}
+
+	return versions, nil
+}
+
+// serverConfig returns the GKE server config of the location of the cluster, which is cached as it only changes
+// when GKE releases new versions.
+func (s *Service) serverConfig(ctx context.Context) (*containerpb.ServerConfig, error) {
+	location := s.scope.ClusterLocation()
+	if serverConfig, ok := s.serverConfigCache.get(location); ok {
+		return serverConfig, nil
+	}
+	serverConfig, err := s.scope.ManagedControlPlaneClient().GetServerConfig(ctx, &containerpb.GetServerConfigRequest{
+		Name: location,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.serverConfigCache.set(location, serverConfig)
+
+	return serverConfig, nil
+}
+
+// serverConfigCache caches the GKE server configs by location, as every cluster in a location would otherwise get
+// it on each reconcile.
+type serverConfigCache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	items map[string]serverConfigEntry
+}
+
+type serverConfigEntry struct {
+	serverConfig *containerpb.ServerConfig
+	expiresAt    time.Time
+}
+
+var defaultServerConfigCache = newServerConfigCache(serverConfigTTL)
+
+func newServerConfigCache(ttl time.Duration) *serverConfigCache {
+	return &serverConfigCache{
+		ttl:   ttl,
+		items: map[string]serverConfigEntry{},
+	}
+}
+
+func (c *serverConfigCache) get(location string) (*containerpb.ServerConfig, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.items[location]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.serverConfig, true
+}
+
+func (c *serverConfigCache) set(location string, serverConfig *containerpb.ServerConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[location] = serverConfigEntry{serverConfig: serverConfig, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// validMasterVersions returns the control plane versions GKE accepts for clusters in the release channel.
+func validMasterVersions(serverConfig *containerpb.ServerConfig, channel containerpb.ReleaseChannel_Channel) []string {
+	if channel == containerpb.ReleaseChannel_UNSPECIFIED {
+		return serverConfig.GetValidMasterVersions()
+	}
+	for _, channelConfig := range serverConfig.GetChannels() {
+		if channelConfig.GetChannel() == channel {
+			return channelConfig.GetValidVersions()
+		}
+	}
+
+	return nil
+}
+
+// allowedMasterVersions returns the valid versions newer than the current version that the control plane can be
+// upgraded to without breaking the version skew with the node pools.
+func allowedMasterVersions(validVersions []string, current string, nodeVersions []nodePoolVersion) []string {
+	currentVersion, err := version.ParseGeneric(current)
+	if err != nil {
+		return nil
+	}
+
+	var allowed []string
+	for _, valid := range validVersions {
+		valid = convertToSdkMasterVersion(valid)
+		validVersion, err := version.ParseGeneric(valid)
+		if err != nil || !validVersion.GreaterThan(currentVersion) || slices.Contains(allowed, valid) {
+			continue
+		}
+		if validVersion.Minor() > currentVersion.Minor()+1 || nodeVersionSkew(validVersion, nodeVersions) != nil {
+			continue
+		}
+		allowed = append(allowed, valid)
+	}
+
+	return allowed
+}
+
+// validateMasterVersion checks that the control plane can be upgraded from the current to the desired version. It
+// returns the reason and the error blocking the upgrade. Versions that can't be parsed, such as latest, are left to
+// GKE to validate.
+func validateMasterVersion(desired, current string, validVersions []string, nodeVersions []nodePoolVersion) (string, error) {
+	desiredVersion, err := version.ParseGeneric(desired)
+	if err != nil {
+		return "", nil //nolint:nilerr
+	}
+	currentVersion, err := version.ParseGeneric(current)
+	if err != nil {
+		return "", nil //nolint:nilerr
+	}
+
+	if isVersionDowngrade(desiredVersion, currentVersion) {
+		return infrav1exp.GKEControlPlaneVersionDowngradeReason, fmt.Errorf("version %s is older than the current version %s", desired, current)
+	}
+	if desiredVersion.Minor() > currentVersion.Minor()+1 {
+		return infrav1exp.GKEControlPlaneVersionUnavailableReason, fmt.Errorf("version %s skips a minor version, the control plane can only be upgraded one minor version at a time from %s", desired, current)
+	}
+	if !slices.ContainsFunc(validVersions, func(valid string) bool { return versionMatches(desiredVersion, valid) }) {
+		return infrav1exp.GKEControlPlaneVersionUnavailableReason, fmt.Errorf("version %s is not available in the release channel of the cluster", desired)
+	}
+	if err := nodeVersionSkew(desiredVersion, nodeVersions); err != nil {
+		return infrav1exp.GKEControlPlaneVersionSkewReason, err
+	}
+
+	return "", nil
+}
+
+// isVersionDowngrade returns true if the desired version is older than the current one. Desired versions without a
+// patch version are compared on their minor version.
+func isVersionDowngrade(desired, current *version.Version) bool {
+	if desired.Major() != current.Major() {
+		return desired.Major() < current.Major()
+	}
+	if desired.Minor() != current.Minor() {
+		return desired.Minor() < current.Minor()
+	}
+
+	return len(desired.Components()) > 2 && desired.Patch() < current.Patch()
+}
+
+// versionMatches returns true if the GKE version matches the desired version, which may omit the patch version.
+func versionMatches(desired *version.Version, gkeVersion string) bool {
+	v, err := version.ParseGeneric(convertToSdkMasterVersion(gkeVersion))
+	if err != nil {
+		return false
+	}
+	if v.Major() != desired.Major() || v.Minor() != desired.Minor() {
+		return false
+	}
+
+	return len(desired.Components()) < 3 || v.Patch() == desired.Patch()
+}
+
+// nodeVersionSkew returns an error if a node pool would be more than maxNodeVersionSkew minor versions older than
+// the control plane version.
+func nodeVersionSkew(controlPlaneVersion *version.Version, nodeVersions []nodePoolVersion) error {
+	for _, node := range nodeVersions {
+		nodeVersion, err := version.ParseGeneric(convertToSdkMasterVersion(node.version))
+		if err != nil {
+			continue
+		}
+		if nodeVersion.Major() == controlPlaneVersion.Major() && controlPlaneVersion.Minor() > nodeVersion.Minor()+maxNodeVersionSkew {
+			return fmt.Errorf("node pool %s at version %s would be more than %d minor versions older than the control plane version %s",
+				node.name, convertToSdkMasterVersion(node.version), maxNodeVersionSkew, controlPlaneVersion)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestValidMasterVersions(t *testing.T) {
+	serverConfig := &containerpb.ServerConfig{
+		ValidMasterVersions: []string{"1.31.1-gke.100", "1.30.5-gke.200", "1.29.8-gke.300"},
+		Channels: []*containerpb.ServerConfig_ReleaseChannelConfig{
+			{
+				Channel:       containerpb.ReleaseChannel_STABLE,
+				ValidVersions: []string{"1.29.8-gke.300"},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(serverConfig.GetValidMasterVersions(), validMasterVersions(serverConfig, containerpb.ReleaseChannel_UNSPECIFIED)); diff != "" {
+		t.Errorf("validMasterVersions() without channel mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"1.29.8-gke.300"}, validMasterVersions(serverConfig, containerpb.ReleaseChannel_STABLE)); diff != "" {
+		t.Errorf("validMasterVersions() in stable channel mismatch (-want +got):\n%s", diff)
+	}
+	if got := validMasterVersions(serverConfig, containerpb.ReleaseChannel_RAPID); got != nil {
+		t.Errorf("validMasterVersions() in unknown channel = %v, want nil", got)
+	}
+}
+
+func TestAllowedMasterVersions(t *testing.T) {
+	validVersions := []string{"1.31.1-gke.100", "1.30.6-gke.200", "1.30.5-gke.300", "1.30.5-gke.100", "1.29.9-gke.100", "1.29.8-gke.100"}
+	tests := []struct {
+		name         string
+		nodeVersions []nodePoolVersion
+		want         []string
+	}{
+		{
+			name: "no node pools",
+			want: []string{"1.30.6", "1.30.5", "1.29.9"},
+		},
+		{
+			name:         "node pools at the maximum skew",
+			nodeVersions: []nodePoolVersion{{name: "pool-0", version: "1.28.10-gke.100"}, {name: "pool-1", version: "1.27.16-gke.100"}},
+			want:         []string{"1.29.9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, allowedMasterVersions(validVersions, "1.29.8", tt.nodeVersions)); diff != "" {
+				t.Errorf("allowedMasterVersions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateMasterVersion(t *testing.T) {
+	validVersions := []string{"1.31.1-gke.100", "1.30.5-gke.100", "1.29.8-gke.100"}
+	tests := []struct {
+		name         string
+		desired      string
+		nodeVersions []nodePoolVersion
+		wantReason   string
+	}{
+		{
+			name:    "patch upgrade",
+			desired: "1.29.8",
+		},
+		{
+			name:         "minor upgrade within skew",
+			desired:      "1.30",
+			nodeVersions: []nodePoolVersion{{name: "pool-0", version: "1.28.10-gke.100"}},
+		},
+		{
+			name:       "downgrade",
+			desired:    "1.28.10",
+			wantReason: infrav1exp.GKEControlPlaneVersionDowngradeReason,
+		},
+		{
+			name:       "skipping a minor version",
+			desired:    "1.31.1",
+			wantReason: infrav1exp.GKEControlPlaneVersionUnavailableReason,
+		},
+		{
+			name:       "not in release channel",
+			desired:    "1.30.4",
+			wantReason: infrav1exp.GKEControlPlaneVersionUnavailableReason,
+		},
+		{
+			name:         "node pool skew",
+			desired:      "1.30.5",
+			nodeVersions: []nodePoolVersion{{name: "pool-0", version: "1.27.16-gke.100"}},
+			wantReason:   infrav1exp.GKEControlPlaneVersionSkewReason,
+		},
+		{
+			name:    "latest is left to GKE",
+			desired: "latest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, err := validateMasterVersion(tt.desired, "1.29.7", validVersions, tt.nodeVersions)
+			if reason != tt.wantReason {
+				t.Errorf("validateMasterVersion() reason = %q, want %q", reason, tt.wantReason)
+			}
+			if (err != nil) != (tt.wantReason != "") {
+				t.Errorf("validateMasterVersion() error = %v, want error %v", err, tt.wantReason != "")
+			}
+		})
+	}
+}
+
+func TestReconcileVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		desired         *string
+		serverConfigErr error
+		wantErr         bool
+		wantBlocked     bool
+		wantAllowed     []string
+	}{
+		{
+			name:        "no desired version",
+			wantAllowed: []string{"1.30.5"},
+		},
+		{
+			name:        "desired version is current",
+			desired:     ptr.To("1.29.8-gke.100"),
+			wantAllowed: []string{"1.30.5"},
+		},
+		{
+			name:        "upgrade",
+			desired:     ptr.To("1.30.5"),
+			wantAllowed: []string{"1.30.5"},
+		},
+		{
+			name:        "blocked upgrade",
+			desired:     ptr.To("1.31.1"),
+			wantBlocked: true,
+			wantAllowed: []string{"1.30.5"},
+		},
+		{
+			name:            "server config unavailable without upgrade",
+			desired:         ptr.To("1.29.8"),
+			serverConfigErr: grpcstatus.Error(codes.PermissionDenied, "permission denied"),
+		},
+		{
+			name:            "server config unavailable for upgrade",
+			desired:         ptr.To("1.30.5"),
+			serverConfigErr: grpcstatus.Error(codes.PermissionDenied, "permission denied"),
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := newUnchangedCluster()
+			cluster.CurrentMasterVersion = "1.29.8-gke.100"
+			server := &fakeClusterManager{
+				cluster:         cluster,
+				serverConfig:    &containerpb.ServerConfig{ValidMasterVersions: []string{"1.31.1-gke.100", "1.30.5-gke.100", "1.29.8-gke.100"}},
+				serverConfigErr: tt.serverConfigErr,
+			}
+			gcpManagedControlPlane := &infrav1exp.GCPManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-control-plane",
+					Namespace: "default",
+				},
+				Spec: infrav1exp.GCPManagedControlPlaneSpec{
+					ClusterName:         "my-cluster",
+					Project:             "my-project",
+					Location:            "us-central1",
+					ControlPlaneVersion: tt.desired,
+				},
+			}
+			s := New(newTestManagedControlPlaneScope(t, server, gcpManagedControlPlane, nil))
+			s.serverConfigCache = newServerConfigCache(time.Hour)
+
+			log := logr.Discard()
+			blocked, err := s.reconcileVersion(context.TODO(), cluster, &log)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("reconcileVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if blocked != tt.wantBlocked {
+				t.Errorf("reconcileVersion() blocked = %v, want %v", blocked, tt.wantBlocked)
+			}
+			if diff := cmp.Diff(tt.wantAllowed, gcpManagedControlPlane.Status.AllowedVersions); diff != "" {
+				t.Errorf("reconcileVersion() allowed versions mismatch (-want +got):\n%s", diff)
+			}
+			if !tt.wantErr && conditions.IsFalse(gcpManagedControlPlane, infrav1exp.GKEControlPlaneVersionValidCondition) != tt.wantBlocked {
+				t.Errorf("reconcileVersion() set condition %v, want blocked %v", conditions.Get(gcpManagedControlPlane, infrav1exp.GKEControlPlaneVersionValidCondition), tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestServerConfigCache(t *testing.T) {
+	cluster := newUnchangedCluster()
+	cluster.CurrentMasterVersion = "1.29.8-gke.100"
+	server := &fakeClusterManager{cluster: cluster}
+	gcpManagedControlPlane := &infrav1exp.GCPManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-control-plane",
+			Namespace: "default",
+		},
+		Spec: infrav1exp.GCPManagedControlPlaneSpec{
+			ClusterName: "my-cluster",
+			Project:     "my-project",
+			Location:    "us-central1",
+		},
+	}
+	s := New(newTestManagedControlPlaneScope(t, server, gcpManagedControlPlane, nil))
+	s.serverConfigCache = newServerConfigCache(time.Hour)
+
+	for range 2 {
+		if _, err := s.serverConfig(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := server.serverConfigRequests.Load(); got != 1 {
+		t.Errorf("serverConfig() sent %d requests, want 1 while cached", got)
+	}
+
+	s.serverConfigCache.ttl = 0
+	s.serverConfigCache.set(s.scope.ClusterLocation(), &containerpb.ServerConfig{})
+	if _, err := s.serverConfig(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if got := server.serverConfigRequests.Load(); got != 2 {
+		t.Errorf("serverConfig() sent %d requests, want 2 after expiry", got)
+	}
+}
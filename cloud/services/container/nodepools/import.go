@@ -0,0 +1,107 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"maps"
+	"slices"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ErrUnownedNodePool is returned when a node pool that wasn't created by Cluster API exists and import is not enabled.
+var ErrUnownedNodePool = errors.New("node pool exists but was not created by Cluster API, set spec.import to adopt it")
+
+// isNodePoolLabeled returns true if the node pool carries the ownership label of the cluster.
+func (s *Service) isNodePoolLabeled(nodePool *containerpb.NodePool) bool {
+	return scope.IsOwnedResource(nodePool.GetConfig().GetResourceLabels(), s.scope.GCPManagedControlPlane.Spec.ClusterName)
+}
+
+// isNodePoolOwned returns true if the node pool was created by Cluster API. Node pools created before ownership
+// labels were introduced are recognized by the creating condition, unless the machine pool imports the node pool.
+func (s *Service) isNodePoolOwned(nodePool *containerpb.NodePool) bool {
+	if s.isNodePoolLabeled(nodePool) {
+		return true
+	}
+	return !s.scope.GCPManagedMachinePool.Spec.Import && conditions.Has(s.scope.GCPManagedMachinePool, infrav1exp.GKEMachinePoolCreatingCondition)
+}
+
+// reconcileOwnership makes sure the existing node pool can be managed. Imported node pools are adopted and the spec
+// populated from them. Node pools created before ownership labels were introduced are labeled by the config update.
+func (s *Service) reconcileOwnership(nodePool *containerpb.NodePool, log *logr.Logger) error {
+	if s.isNodePoolOwned(nodePool) {
+		return nil
+	}
+	if !s.scope.GCPManagedMachinePool.Spec.Import {
+		return ErrUnownedNodePool
+	}
+	if s.scope.GCPManagedMachinePool.Status.Imported {
+		return nil
+	}
+
+	populateSpecFromNodePool(&s.scope.GCPManagedMachinePool.Spec, nodePool)
+	s.scope.GCPManagedMachinePool.Status.Imported = true
+	log.Info("Adopted existing node pool", "name", nodePool.GetName())
+	return nil
+}
+
+// populateSpecFromNodePool sets the unset fields of the spec, which would otherwise change the node pool, from the
+// live node pool.
+func populateSpecFromNodePool(spec *infrav1exp.GCPManagedMachinePoolSpec, nodePool *containerpb.NodePool) {
+	if spec.Scaling == nil {
+		spec.Scaling = infrav1exp.ConvertFromSdkAutoscaling(nodePool.GetAutoscaling(), int32(len(nodePool.GetLocations()))) //nolint:gosec
+	}
+	if spec.NodeLocations == nil {
+		spec.NodeLocations = nodePool.GetLocations()
+	}
+	if spec.KubernetesLabels == nil && len(nodePool.GetConfig().GetLabels()) > 0 {
+		spec.KubernetesLabels = infrav1.Labels(maps.Clone(nodePool.GetConfig().GetLabels()))
+	}
+	if spec.KubernetesTaints == nil && len(nodePool.GetConfig().GetTaints()) > 0 {
+		spec.KubernetesTaints = infrav1exp.ConvertFromSdkTaint(nodePool.GetConfig().GetTaints())
+	}
+	if spec.AdditionalLabels == nil && len(nodePool.GetConfig().GetResourceLabels()) > 0 {
+		spec.AdditionalLabels = infrav1.Labels(maps.Clone(nodePool.GetConfig().GetResourceLabels()))
+	}
+	if spec.ImageType == nil && nodePool.GetConfig().GetImageType() != "" {
+		spec.ImageType = ptr.To(nodePool.GetConfig().GetImageType())
+	}
+	if spec.DiskType == nil && nodePool.GetConfig().GetDiskType() != "" {
+		spec.DiskType = ptr.To(infrav1exp.DiskType(nodePool.GetConfig().GetDiskType()))
+	}
+	if spec.DiskSizeGB == nil && spec.DiskSizeGb == nil && nodePool.GetConfig().GetDiskSizeGb() != 0 {
+		spec.DiskSizeGB = ptr.To(int64(nodePool.GetConfig().GetDiskSizeGb()))
+	}
+	// Network tags, the Linux node config and resource manager tags are always compared, an unset field would
+	// clear them on the node pool.
+	if spec.NodeNetwork.Tags == nil && len(nodePool.GetConfig().GetTags()) > 0 {
+		spec.NodeNetwork.Tags = slices.Clone(nodePool.GetConfig().GetTags())
+	}
+	if spec.LinuxNodeConfig == nil {
+		spec.LinuxNodeConfig = infrav1exp.ConvertFromSdkLinuxNodeConfig(nodePool.GetConfig().GetLinuxNodeConfig())
+	}
+	if spec.ResourceManagerTags == nil && len(nodePool.GetConfig().GetResourceManagerTags().GetTags()) > 0 {
+		spec.ResourceManagerTags = maps.Clone(nodePool.GetConfig().GetResourceManagerTags().GetTags())
+	}
+}
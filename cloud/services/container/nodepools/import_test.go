@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"testing"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1exp "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+)
+
+// newImportedNodePool returns a node pool that was not created by Cluster API.
+func newImportedNodePool() *containerpb.NodePool {
+	return &containerpb.NodePool{
+		Name:      "default-pool",
+		Locations: []string{"us-central1-a", "us-central1-b"},
+		Autoscaling: &containerpb.NodePoolAutoscaling{
+			Enabled:        true,
+			MinNodeCount:   1,
+			MaxNodeCount:   3,
+			LocationPolicy: containerpb.NodePoolAutoscaling_BALANCED,
+		},
+		Config: &containerpb.NodeConfig{
+			Labels: map[string]string{"workload": "batch"},
+			Taints: []*containerpb.NodeTaint{
+				{Key: "dedicated", Value: "batch", Effect: containerpb.NodeTaint_NO_SCHEDULE},
+			},
+			ResourceLabels: map[string]string{"team": "data"},
+			ImageType:      "COS_CONTAINERD",
+			DiskType:       "pd-balanced",
+			DiskSizeGb:     100,
+			Tags:           []string{"allow-ingress"},
+			LinuxNodeConfig: &containerpb.LinuxNodeConfig{
+				Sysctls:    map[string]string{"net.core.somaxconn": "4096"},
+				CgroupMode: containerpb.LinuxNodeConfig_CGROUP_MODE_V2,
+			},
+			ResourceManagerTags: &containerpb.ResourceManagerTags{
+				Tags: map[string]string{"tagKeys/123": "tagValues/456"},
+			},
+		},
+	}
+}
+
+func TestPopulateSpecFromNodePool(t *testing.T) {
+	nodePool := newImportedNodePool()
+
+	tests := []struct {
+		name string
+		spec infrav1exp.GCPManagedMachinePoolSpec
+		want infrav1exp.GCPManagedMachinePoolSpec
+	}{
+		{
+			name: "empty spec",
+			spec: infrav1exp.GCPManagedMachinePoolSpec{},
+			want: infrav1exp.GCPManagedMachinePoolSpec{
+				Scaling: &infrav1exp.NodePoolAutoScaling{
+					EnableAutoscaling: ptr.To(true),
+					MinCount:          ptr.To[int32](2),
+					MaxCount:          ptr.To[int32](6),
+					LocationPolicy:    ptr.To(infrav1exp.ManagedNodePoolLocationPolicyBalanced),
+				},
+				NodeLocations:    []string{"us-central1-a", "us-central1-b"},
+				KubernetesLabels: infrav1.Labels{"workload": "batch"},
+				KubernetesTaints: infrav1exp.Taints{
+					{Key: "dedicated", Value: "batch", Effect: "NoSchedule"},
+				},
+				AdditionalLabels: infrav1.Labels{"team": "data"},
+				ImageType:        ptr.To("COS_CONTAINERD"),
+				DiskType:         ptr.To(infrav1exp.Balanced),
+				DiskSizeGB:       ptr.To[int64](100),
+				NodeNetwork: infrav1exp.NodeNetworkConfig{
+					Tags: []string{"allow-ingress"},
+				},
+				LinuxNodeConfig: &infrav1exp.LinuxNodeConfig{
+					Sysctls:    []infrav1exp.SysctlConfig{{Parameter: "net.core.somaxconn", Value: "4096"}},
+					CgroupMode: ptr.To[infrav1exp.ManagedNodePoolCgroupMode](2),
+				},
+				ResourceManagerTags: map[string]string{"tagKeys/123": "tagValues/456"},
+			},
+		},
+		{
+			name: "set fields are kept",
+			spec: infrav1exp.GCPManagedMachinePoolSpec{
+				Scaling: &infrav1exp.NodePoolAutoScaling{
+					EnableAutoscaling: ptr.To(false),
+				},
+				KubernetesLabels: infrav1.Labels{"workload": "web"},
+			},
+			want: infrav1exp.GCPManagedMachinePoolSpec{
+				Scaling: &infrav1exp.NodePoolAutoScaling{
+					EnableAutoscaling: ptr.To(false),
+				},
+				NodeLocations:    []string{"us-central1-a", "us-central1-b"},
+				KubernetesLabels: infrav1.Labels{"workload": "web"},
+				KubernetesTaints: infrav1exp.Taints{
+					{Key: "dedicated", Value: "batch", Effect: "NoSchedule"},
+				},
+				AdditionalLabels: infrav1.Labels{"team": "data"},
+				ImageType:        ptr.To("COS_CONTAINERD"),
+				DiskType:         ptr.To(infrav1exp.Balanced),
+				DiskSizeGB:       ptr.To[int64](100),
+				NodeNetwork: infrav1exp.NodeNetworkConfig{
+					Tags: []string{"allow-ingress"},
+				},
+				LinuxNodeConfig: &infrav1exp.LinuxNodeConfig{
+					Sysctls:    []infrav1exp.SysctlConfig{{Parameter: "net.core.somaxconn", Value: "4096"}},
+					CgroupMode: ptr.To[infrav1exp.ManagedNodePoolCgroupMode](2),
+				},
+				ResourceManagerTags: map[string]string{"tagKeys/123": "tagValues/456"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			populateSpecFromNodePool(&spec, nodePool)
+			if diff := cmp.Diff(tt.want, spec); diff != "" {
+				t.Errorf("populateSpecFromNodePool() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckDiffAndPrepareUpdateConfigImported(t *testing.T) {
+	nodePool := newImportedNodePool()
+	gcpManagedMachinePool := &infrav1exp.GCPManagedMachinePool{
+		Spec: infrav1exp.GCPManagedMachinePoolSpec{
+			NodePoolName: nodePool.GetName(),
+			Import:       true,
+		},
+	}
+	populateSpecFromNodePool(&gcpManagedMachinePool.Spec, nodePool)
+
+	s := New(&scope.ManagedMachinePoolScope{
+		GCPManagedControlPlane: &infrav1exp.GCPManagedControlPlane{
+			Spec: infrav1exp.GCPManagedControlPlaneSpec{
+				ClusterName: "my-cluster",
+				Project:     "my-project",
+				Location:    "us-central1",
+			},
+		},
+		GCPManagedMachinePool: gcpManagedMachinePool,
+		MachinePool: &clusterv1exp.MachinePool{
+			Spec: clusterv1exp.MachinePoolSpec{
+				Replicas: ptr.To[int32](2),
+			},
+		},
+	})
+	if needUpdate, request := s.checkDiffAndPrepareUpdateConfig(nodePool); needUpdate {
+		t.Errorf("checkDiffAndPrepareUpdateConfig() needUpdate = true for an imported node pool: %v", request)
+	}
+}
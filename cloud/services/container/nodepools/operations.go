@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"context"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"github.com/go-logr/logr"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// setOperation records the GKE operation started on the node pool in status.
+func (s *Service) setOperation(operation *containerpb.Operation) {
+	s.scope.GCPManagedMachinePool.Status.Operation = infrav1exp.ConvertFromSdkOperation(operation)
+}
+
+// operationFailed returns whether the last GKE operation recorded in status failed. Its failure is kept in the
+// conditions until a new operation replaces it.
+func (s *Service) operationFailed() bool {
+	operation := s.scope.GCPManagedMachinePool.Status.Operation
+	return operation != nil && operation.Error != ""
+}
+
+// reconcileOperation polls the GKE operation recorded in status until it completes and reports its failure in the
+// conditions. It returns true while the operation is in progress, in which case the node pool is not described.
+// Upgrades keep describing the node pool to report the blue-green phase.
+func (s *Service) reconcileOperation(ctx context.Context, log *logr.Logger) (bool, error) {
+	recorded := s.scope.GCPManagedMachinePool.Status.Operation
+	if recorded == nil || recorded.Status == containerpb.Operation_DONE.String() {
+		return false, nil
+	}
+
+	operation, err := s.scope.ManagedMachinePoolClient().GetOperation(ctx, &containerpb.GetOperationRequest{
+		Name: s.scope.OperationFullName(recorded.Name),
+	})
+	if err != nil {
+		var e *apierror.APIError
+		if ok := errors.As(err, &e); ok && e.GRPCStatus().Code() == codes.NotFound {
+			// GKE only keeps operations for a limited time.
+			s.scope.GCPManagedMachinePool.Status.Operation = nil
+			return false, nil
+		}
+		log.Error(err, "Error getting GKE operation", "operation", recorded.Name)
+		return false, err
+	}
+	s.setOperation(operation)
+
+	if operation.GetStatus() != containerpb.Operation_DONE {
+		log.Info("GKE operation in progress", "operation", operation.GetName(), "type", operation.GetOperationType(),
+			"progress", s.scope.GCPManagedMachinePool.Status.Operation.Progress)
+		upgrade := s.scope.GCPManagedMachinePool.Status.Upgrade
+		return upgrade == nil || upgrade.Operation != operation.GetName(), nil
+	}
+
+	if msg := operation.GetError().GetMessage(); msg != "" {
+		log.Error(errors.New(msg), "GKE operation failed", "operation", operation.GetName(), "type", operation.GetOperationType())
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolOperationFailedReason, clusterv1.ConditionSeverityError,
+			"%s operation %s failed: %s", operation.GetOperationType(), operation.GetName(), msg)
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition, infrav1exp.GKEMachinePoolOperationFailedReason, clusterv1.ConditionSeverityError,
+			"%s operation %s failed: %s", operation.GetOperationType(), operation.GetName(), msg)
+	}
+
+	return false, nil
+}
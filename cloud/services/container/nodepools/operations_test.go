@@ -0,0 +1,150 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"context"
+	"testing"
+
+	computerest "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"google.golang.org/api/option"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/container/internal/testutil"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	clusterv1exp "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeClusterManager serves the GKE operation and node pool the node pool reconciliation reads.
+type fakeClusterManager struct {
+	containerpb.UnimplementedClusterManagerServer
+	operation *containerpb.Operation
+	nodePool  *containerpb.NodePool
+}
+
+func (f *fakeClusterManager) GetOperation(context.Context, *containerpb.GetOperationRequest) (*containerpb.Operation, error) {
+	return f.operation, nil
+}
+
+func (f *fakeClusterManager) GetNodePool(context.Context, *containerpb.GetNodePoolRequest) (*containerpb.NodePool, error) {
+	return f.nodePool, nil
+}
+
+func TestReconcileFailedOperation(t *testing.T) {
+	nodePool := newImportedNodePool()
+	nodePool.Status = containerpb.NodePool_RUNNING
+	nodePool.Autoscaling = &containerpb.NodePoolAutoscaling{
+		Enabled:           true,
+		TotalMinNodeCount: 2,
+		TotalMaxNodeCount: 6,
+		LocationPolicy:    containerpb.NodePoolAutoscaling_BALANCED,
+	}
+	server := &fakeClusterManager{
+		operation: &containerpb.Operation{
+			Name:          "operation-1",
+			OperationType: containerpb.Operation_UPGRADE_NODES,
+			Status:        containerpb.Operation_DONE,
+			Error:         &status.Status{Code: int32(code.Code_RESOURCE_EXHAUSTED), Message: "quota exceeded"},
+		},
+		nodePool: nodePool,
+	}
+
+	gcpManagedMachinePool := &infrav1exp.GCPManagedMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pool",
+			Namespace: "default",
+		},
+		Spec: infrav1exp.GCPManagedMachinePoolSpec{
+			NodePoolName: nodePool.GetName(),
+			Import:       true,
+		},
+		Status: infrav1exp.GCPManagedMachinePoolStatus{
+			Operation: &infrav1exp.GKEOperation{
+				Name:   "operation-1",
+				Type:   "UPGRADE_NODES",
+				Status: "RUNNING",
+			},
+		},
+	}
+	populateSpecFromNodePool(&gcpManagedMachinePool.Spec, nodePool)
+
+	scheme := runtime.NewScheme()
+	if err := infrav1exp.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	instanceGroupManagersClient, err := computerest.NewInstanceGroupManagersRESTClient(context.TODO(), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	managedMachinePoolScope, err := scope.NewManagedMachinePoolScope(context.TODO(), scope.ManagedMachinePoolScopeParams{
+		ManagedClusterClient:        testutil.NewFakeClusterManagerClient(t, server),
+		InstanceGroupManagersClient: instanceGroupManagersClient,
+		Client:                      fake.NewClientBuilder().WithScheme(scheme).WithObjects(gcpManagedMachinePool).Build(),
+		Cluster:                     &clusterv1.Cluster{},
+		MachinePool: &clusterv1exp.MachinePool{
+			Spec: clusterv1exp.MachinePoolSpec{
+				Replicas: ptr.To[int32](2),
+			},
+		},
+		GCPManagedCluster: &infrav1exp.GCPManagedCluster{},
+		GCPManagedControlPlane: &infrav1exp.GCPManagedControlPlane{
+			Spec: infrav1exp.GCPManagedControlPlaneSpec{
+				ClusterName: "my-cluster",
+				Project:     "my-project",
+				Location:    "us-central1",
+			},
+		},
+		GCPManagedMachinePool: gcpManagedMachinePool,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The failure of the operation is reported by the pass that sees it complete, and is kept by the next ones
+	// although the node pool is running and up to date.
+	s := New(managedMachinePoolScope)
+	for range 2 {
+		if _, err := s.Reconcile(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+		for _, conditionType := range []clusterv1.ConditionType{clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolReadyCondition} {
+			if !conditions.IsFalse(gcpManagedMachinePool, conditionType) {
+				t.Errorf("Reconcile() set condition %s to %v, want False", conditionType, conditions.Get(gcpManagedMachinePool, conditionType))
+			}
+			if reason := conditions.GetReason(gcpManagedMachinePool, conditionType); reason != infrav1exp.GKEMachinePoolOperationFailedReason {
+				t.Errorf("Reconcile() set condition %s reason to %q, want %q", conditionType, reason, infrav1exp.GKEMachinePoolOperationFailedReason)
+			}
+		}
+	}
+
+	// A new operation replaces the failed one.
+	gcpManagedMachinePool.Status.Operation = &infrav1exp.GKEOperation{Name: "operation-2", Status: "DONE"}
+	if _, err := s.Reconcile(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if !conditions.IsTrue(gcpManagedMachinePool, clusterv1.ReadyCondition) {
+		t.Errorf("Reconcile() set condition Ready to %v after a new operation, want True", conditions.Get(gcpManagedMachinePool, clusterv1.ReadyCondition))
+	}
+}
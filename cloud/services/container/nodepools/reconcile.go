@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/cluster-api-provider-gcp/util/resourceurl"
 
@@ -33,6 +34,8 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/googleapis/gax-go/v2/apierror"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/providerid"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-gcp/cloud/services/shared"
@@ -63,11 +66,27 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	// Update GCPManagedMachinePool ready status based on conditions
 	defer s.setReadyStatusFromConditions()
 
+	operationInProgress, err := s.reconcileOperation(ctx, &log)
+	if err != nil {
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{}, err
+	}
+	if operationInProgress {
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
+
 	nodePool, err := s.describeNodePool(ctx, &log)
 	if err != nil {
 		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return ctrl.Result{}, err
 	}
+	if nodePool == nil && s.scope.GCPManagedMachinePool.Spec.Import {
+		err := errors.New("node pool to import not found")
+		log.Error(err, "Failed to import node pool", "name", s.scope.NodePoolName())
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
+	}
 	if nodePool == nil {
 		log.Info("Node pool not found, creating", "cluster", s.scope.Cluster.Name)
 		if err = s.createNodePool(ctx, &log); err != nil {
@@ -83,8 +102,14 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
 	}
 	log.V(2).Info("Node pool found", "cluster", s.scope.Cluster.Name, "nodepool", nodePool.GetName())
+	if err := s.reconcileOwnership(nodePool, &log); err != nil {
+		log.Error(err, "Failed to reconcile node pool ownership")
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolNotOwnedReason, clusterv1.ConditionSeverityError, err.Error())
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition, infrav1exp.GKEMachinePoolNotOwnedReason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{}, err
+	}
 
-	instances, err := s.getInstances(ctx, nodePool)
+	instances, zones, err := s.getInstances(ctx, nodePool)
 	if err != nil {
 		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		return ctrl.Result{}, err
@@ -102,6 +127,8 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	}
 	s.scope.GCPManagedMachinePool.Spec.ProviderIDList = providerIDList
 	s.scope.GCPManagedMachinePool.Status.Replicas = int32(len(providerIDList))
+	s.scope.GCPManagedMachinePool.Status.Zones = zones
+	s.scope.GCPManagedMachinePool.Status.GKEConditions = infrav1exp.ConvertFromSdkStatusConditions(nodePool.GetConditions())
 
 	// Update GKEManagedMachinePool conditions based on GKE node pool status
 	switch nodePool.GetStatus() {
@@ -115,6 +142,9 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	case containerpb.NodePool_RECONCILING:
 		// node pool is updating/reconciling
 		log.Info("Node pool reconciling in progress")
+		if err := s.updateUpgradeStatus(ctx, nodePool); err != nil {
+			log.Error(err, "Failed to get node pool upgrade progress", "name", s.scope.GCPManagedMachinePool.Name)
+		}
 		conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolUpdatingCondition)
 		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
 	case containerpb.NodePool_STOPPING:
@@ -126,17 +156,21 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		return ctrl.Result{}, nil
 	case containerpb.NodePool_ERROR, containerpb.NodePool_RUNNING_WITH_ERROR:
 		// node pool is in error or degraded state
-		var msg string
-		if len(nodePool.GetConditions()) > 0 {
-			msg = nodePool.GetConditions()[0].GetMessage()
+		msg := shared.StatusConditionsMessage(nodePool.GetConditions())
+		if msg == "" {
+			msg = fmt.Sprintf("GKE node pool is in %s state", nodePool.GetStatus())
 		}
 		log.Error(errors.New("Node pool in error/degraded state"), msg, "name", s.scope.GCPManagedMachinePool.Name)
-		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition, infrav1exp.GKEMachinePoolErrorReason, clusterv1.ConditionSeverityError, "")
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolErrorReason, clusterv1.ConditionSeverityError, "%s", msg)
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition, infrav1exp.GKEMachinePoolErrorReason, clusterv1.ConditionSeverityError, "%s", msg)
 		return ctrl.Result{}, nil
 	case containerpb.NodePool_RUNNING:
 		// node pool is ready and running
-		conditions.MarkTrue(s.scope.ConditionSetter(), clusterv1.ReadyCondition)
-		conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition)
+		s.scope.GCPManagedMachinePool.Status.Upgrade = nil
+		if !s.operationFailed() {
+			conditions.MarkTrue(s.scope.ConditionSetter(), clusterv1.ReadyCondition)
+			conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition)
+		}
 		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolCreatingCondition, infrav1exp.GKEMachinePoolCreatedReason, clusterv1.ConditionSeverityInfo, "")
 		log.Info("Node pool running")
 	default:
@@ -147,9 +181,17 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	needUpdateConfig, nodePoolUpdateConfigRequest := s.checkDiffAndPrepareUpdateConfig(nodePool)
 	if needUpdateConfig {
 		log.Info("Node pool config update required", "request", nodePoolUpdateConfigRequest)
-		err = s.updateNodePoolConfig(ctx, nodePoolUpdateConfigRequest)
+		operation, err := s.updateNodePoolConfig(ctx, nodePoolUpdateConfigRequest)
 		if err != nil {
-			return ctrl.Result{}, fmt.Errorf("node pool config update (either version/labels/taints/locations/image type/network tag/linux node config or all) failed: %w", err)
+			return ctrl.Result{}, fmt.Errorf("node pool config update (either version/labels/taints/locations/image type/network tag/linux node config/node config or all) failed: %w", err)
+		}
+		s.setOperation(operation)
+		if nodePoolUpdateConfigRequest.GetNodeVersion() != "" {
+			upgradeSettings := nodePoolUpdateConfigRequest.GetUpgradeSettings()
+			if upgradeSettings == nil {
+				upgradeSettings = nodePool.GetUpgradeSettings()
+			}
+			s.scope.GCPManagedMachinePool.Status.Upgrade = newUpgradeStatus(operation, nodePoolUpdateConfigRequest.GetNodeVersion(), upgradeSettings)
 		}
 		log.Info("Node pool config updating in progress")
 		s.scope.GCPManagedMachinePool.Status.Ready = true
@@ -169,7 +211,11 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 		return ctrl.Result{RequeueAfter: reconciler.DefaultRetryTime}, nil
 	}
 
-	needUpdateSize, setNodePoolSizeRequest := s.checkDiffAndPrepareUpdateSize(nodePool)
+	needUpdateSize, setNodePoolSizeRequest, err := s.checkDiffAndPrepareUpdateSize(nodePool)
+	if err != nil {
+		conditions.MarkFalse(s.scope.ConditionSetter(), clusterv1.ReadyCondition, infrav1exp.GKEMachinePoolReconciliationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{}, err
+	}
 	if needUpdateSize {
 		log.Info("Size update required")
 		err = s.updateNodePoolSize(ctx, setNodePoolSizeRequest)
@@ -186,8 +232,10 @@ func (s *Service) Reconcile(ctx context.Context) (ctrl.Result, error) {
 	s.scope.SetReplicas(int32(len(s.scope.GCPManagedMachinePool.Spec.ProviderIDList)))
 	log.Info("Node pool reconciled")
 	s.scope.GCPManagedMachinePool.Status.Ready = true
-	conditions.MarkTrue(s.scope.ConditionSetter(), clusterv1.ReadyCondition)
-	conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition)
+	if !s.operationFailed() {
+		conditions.MarkTrue(s.scope.ConditionSetter(), clusterv1.ReadyCondition)
+		conditions.MarkTrue(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolReadyCondition)
+	}
 	conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolCreatingCondition, infrav1exp.GKEMachinePoolCreatedReason, clusterv1.ConditionSeverityInfo, "")
 
 	return ctrl.Result{}, nil
@@ -209,6 +257,12 @@ func (s *Service) Delete(ctx context.Context) (ctrl.Result, error) {
 		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolDeletingCondition, infrav1exp.GKEMachinePoolDeletedReason, clusterv1.ConditionSeverityInfo, "")
 		return ctrl.Result{}, err
 	}
+	if !s.isNodePoolOwned(nodePool) && !s.scope.GCPManagedMachinePool.Spec.AllowUnownedDeletion {
+		log.Info("Node pool was not created by Cluster API, leaving it in place")
+		conditions.MarkFalse(s.scope.ConditionSetter(), infrav1exp.GKEMachinePoolDeletingCondition, infrav1exp.GKEMachinePoolDeletedReason, clusterv1.ConditionSeverityInfo,
+			"node pool was not created by Cluster API and was left in place")
+		return ctrl.Result{}, nil
+	}
 
 	switch nodePool.GetStatus() {
 	case containerpb.NodePool_PROVISIONING:
@@ -257,19 +311,21 @@ func (s *Service) describeNodePool(ctx context.Context, log *logr.Logger) (*cont
 	return nodePool, nil
 }
 
-func (s *Service) getInstances(ctx context.Context, nodePool *containerpb.NodePool) ([]*computepb.ManagedInstance, error) {
+func (s *Service) getInstances(ctx context.Context, nodePool *containerpb.NodePool) ([]*computepb.ManagedInstance, []infrav1exp.NodePoolZoneStatus, error) {
 	instances := []*computepb.ManagedInstance{}
+	zones := []infrav1exp.NodePoolZoneStatus{}
 
 	for _, url := range nodePool.GetInstanceGroupUrls() {
 		resourceURL, err := resourceurl.Parse(url)
 		if err != nil {
-			return nil, errors.Wrap(err, "error parsing instance group url")
+			return nil, nil, errors.Wrap(err, "error parsing instance group url")
 		}
 		listManagedInstancesRequest := &computepb.ListManagedInstancesInstanceGroupManagersRequest{
 			InstanceGroupManager: resourceURL.Name,
 			Project:              resourceURL.Project,
 			Zone:                 resourceURL.Location,
 		}
+		zoneInstances := []*computepb.ManagedInstance{}
 		iter := s.scope.InstanceGroupManagersClient().ListManagedInstances(ctx, listManagedInstancesRequest)
 		for {
 			resp, err := iter.Next()
@@ -277,13 +333,41 @@ func (s *Service) getInstances(ctx context.Context, nodePool *containerpb.NodePo
 				break
 			}
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			instances = append(instances, resp)
+			zoneInstances = append(zoneInstances, resp)
+		}
+		instances = append(instances, zoneInstances...)
+		zones = addZoneStatus(zones, resourceURL.Location, zoneInstances)
+	}
+
+	return instances, zones, nil
+}
+
+// addZoneStatus adds the instances of a managed instance group in zone to the per-zone status of the node pool.
+func addZoneStatus(zones []infrav1exp.NodePoolZoneStatus, zone string, instances []*computepb.ManagedInstance) []infrav1exp.NodePoolZoneStatus {
+	var ready int32
+	for _, instance := range instances {
+		if instance.GetInstanceStatus() == computepb.ManagedInstance_RUNNING.String() &&
+			instance.GetCurrentAction() == computepb.ManagedInstance_NONE.String() {
+			ready++
+		}
+	}
+
+	// A node pool can have several instance groups in the same zone.
+	for i := range zones {
+		if zones[i].Zone == zone {
+			zones[i].Replicas += int32(len(instances)) //nolint:gosec
+			zones[i].ReadyReplicas += ready
+			return zones
 		}
 	}
 
-	return instances, nil
+	return append(zones, infrav1exp.NodePoolZoneStatus{
+		Zone:          zone,
+		Replicas:      int32(len(instances)), //nolint:gosec
+		ReadyReplicas: ready,
+	})
 }
 
 func (s *Service) createNodePool(ctx context.Context, log *logr.Logger) error {
@@ -298,37 +382,40 @@ func (s *Service) createNodePool(ctx context.Context, log *logr.Logger) error {
 		NodePool: scope.ConvertToSdkNodePool(*s.scope.GCPManagedMachinePool, *s.scope.MachinePool, isRegional, s.scope.GCPManagedControlPlane.Spec.ClusterName),
 		Parent:   s.scope.NodePoolLocation(),
 	}
-	_, err := s.scope.ManagedMachinePoolClient().CreateNodePool(ctx, createNodePoolRequest)
+	operation, err := s.scope.ManagedMachinePoolClient().CreateNodePool(ctx, createNodePoolRequest)
 	if err != nil {
 		return err
 	}
+	s.setOperation(operation)
 
 	return nil
 }
 
-func (s *Service) updateNodePoolConfig(ctx context.Context, updateNodePoolRequest *containerpb.UpdateNodePoolRequest) error {
-	_, err := s.scope.ManagedMachinePoolClient().UpdateNodePool(ctx, updateNodePoolRequest)
+func (s *Service) updateNodePoolConfig(ctx context.Context, updateNodePoolRequest *containerpb.UpdateNodePoolRequest) (*containerpb.Operation, error) {
+	operation, err := s.scope.ManagedMachinePoolClient().UpdateNodePool(ctx, updateNodePoolRequest)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return operation, nil
 }
 
 func (s *Service) updateNodePoolAutoscaling(ctx context.Context, setNodePoolAutoscalingRequest *containerpb.SetNodePoolAutoscalingRequest) error {
-	_, err := s.scope.ManagedMachinePoolClient().SetNodePoolAutoscaling(ctx, setNodePoolAutoscalingRequest)
+	operation, err := s.scope.ManagedMachinePoolClient().SetNodePoolAutoscaling(ctx, setNodePoolAutoscalingRequest)
 	if err != nil {
 		return err
 	}
+	s.setOperation(operation)
 
 	return nil
 }
 
 func (s *Service) updateNodePoolSize(ctx context.Context, setNodePoolSizeRequest *containerpb.SetNodePoolSizeRequest) error {
-	_, err := s.scope.ManagedMachinePoolClient().SetNodePoolSize(ctx, setNodePoolSizeRequest)
+	operation, err := s.scope.ManagedMachinePoolClient().SetNodePoolSize(ctx, setNodePoolSizeRequest)
 	if err != nil {
 		return err
 	}
+	s.setOperation(operation)
 
 	return nil
 }
@@ -337,10 +424,11 @@ func (s *Service) deleteNodePool(ctx context.Context) error {
 	deleteNodePoolRequest := &containerpb.DeleteNodePoolRequest{
 		Name: s.scope.NodePoolFullName(),
 	}
-	_, err := s.scope.ManagedMachinePoolClient().DeleteNodePool(ctx, deleteNodePoolRequest)
+	operation, err := s.scope.ManagedMachinePoolClient().DeleteNodePool(ctx, deleteNodePoolRequest)
 	if err != nil {
 		return err
 	}
+	s.setOperation(operation)
 
 	return nil
 }
@@ -353,6 +441,10 @@ func (s *Service) checkDiffAndPrepareUpdateConfig(existingNodePool *containerpb.
 
 	isRegional := shared.IsRegional(s.scope.Region())
 	desiredNodePool := scope.ConvertToSdkNodePool(*s.scope.GCPManagedMachinePool, *s.scope.MachinePool, isRegional, s.scope.GCPManagedControlPlane.Spec.ClusterName)
+	if s.scope.GCPManagedMachinePool.Spec.Import && !s.isNodePoolLabeled(existingNodePool) {
+		// Imported node pools are not marked as created by Cluster API.
+		delete(desiredNodePool.GetConfig().GetResourceLabels(), infrav1.ClusterTagKey(s.scope.GCPManagedControlPlane.Spec.ClusterName))
+	}
 
 	// Node version
 	if s.scope.NodePoolVersion() != nil {
@@ -370,7 +462,7 @@ func (s *Service) checkDiffAndPrepareUpdateConfig(existingNodePool *containerpb.
 		}
 	}
 	// Kubernetes taints
-	if !cmp.Equal(desiredNodePool.GetConfig().GetTaints(), existingNodePool.GetConfig().GetTaints()) {
+	if !cmp.Equal(desiredNodePool.GetConfig().GetTaints(), existingNodePool.GetConfig().GetTaints(), cmpopts.IgnoreUnexported(containerpb.NodeTaint{})) {
 		needUpdate = true
 		updateNodePoolRequest.Taints = &containerpb.NodeTaints{
 			Taints: desiredNodePool.GetConfig().GetTaints(),
@@ -383,7 +475,7 @@ func (s *Service) checkDiffAndPrepareUpdateConfig(existingNodePool *containerpb.
 		updateNodePoolRequest.ImageType = desiredNodePool.GetConfig().GetImageType()
 	}
 	// Additional resource labels
-	if !cmp.Equal(desiredNodePool.GetConfig().GetResourceLabels(), existingNodePool.GetConfig().GetResourceLabels()) {
+	if !cmp.Equal(desiredNodePool.GetConfig().GetResourceLabels(), existingNodePool.GetConfig().GetResourceLabels(), cmpopts.EquateEmpty()) {
 		needUpdate = true
 		updateNodePoolRequest.ResourceLabels = &containerpb.ResourceLabels{
 			Labels: desiredNodePool.GetConfig().GetResourceLabels(),
@@ -404,15 +496,102 @@ func (s *Service) checkDiffAndPrepareUpdateConfig(existingNodePool *containerpb.
 		}
 	}
 	// LinuxNodeConfig
+	// GKE omits the Linux node config of node pools that never set one, which matches an empty config.
 	desiredLinuxNodeConfig := infrav1exp.ConvertToSdkLinuxNodeConfig(s.scope.GCPManagedMachinePool.Spec.LinuxNodeConfig)
-	if !cmp.Equal(desiredLinuxNodeConfig, existingNodePool.GetConfig().GetLinuxNodeConfig(), cmpopts.IgnoreUnexported(containerpb.LinuxNodeConfig{})) {
+	existingLinuxNodeConfig := existingNodePool.GetConfig().GetLinuxNodeConfig()
+	if existingLinuxNodeConfig == nil {
+		existingLinuxNodeConfig = &containerpb.LinuxNodeConfig{}
+	}
+	if !cmp.Equal(desiredLinuxNodeConfig, existingLinuxNodeConfig, cmpopts.IgnoreUnexported(containerpb.LinuxNodeConfig{})) {
 		needUpdate = true
 		updateNodePoolRequest.LinuxNodeConfig = desiredLinuxNodeConfig
 	}
+	// Kubelet config
+	// The kubelet settings, workload metadata mode, gVNIC and confidential nodes are only compared when set, as GKE
+	// keeps them when an update doesn't specify them. The webhook rejects removing them from the spec.
+	desiredKubeletConfig := desiredNodePool.GetConfig().GetKubeletConfig()
+	if desiredKubeletConfig != nil && !compareKubeletConfig(desiredKubeletConfig, existingNodePool.GetConfig().GetKubeletConfig()) {
+		needUpdate = true
+		updateNodePoolRequest.KubeletConfig = desiredKubeletConfig
+	}
+	// Workload metadata mode
+	desiredWorkloadMetadataConfig := desiredNodePool.GetConfig().GetWorkloadMetadataConfig()
+	if desiredWorkloadMetadataConfig != nil && desiredWorkloadMetadataConfig.GetMode() != existingNodePool.GetConfig().GetWorkloadMetadataConfig().GetMode() {
+		needUpdate = true
+		updateNodePoolRequest.WorkloadMetadataConfig = desiredWorkloadMetadataConfig
+	}
+	// gVNIC
+	desiredGvnic := desiredNodePool.GetConfig().GetGvnic()
+	if desiredGvnic != nil && desiredGvnic.GetEnabled() != existingNodePool.GetConfig().GetGvnic().GetEnabled() {
+		needUpdate = true
+		updateNodePoolRequest.Gvnic = desiredGvnic
+	}
+	// Confidential nodes
+	desiredConfidentialNodes := desiredNodePool.GetConfig().GetConfidentialNodes()
+	if desiredConfidentialNodes != nil && desiredConfidentialNodes.GetEnabled() != existingNodePool.GetConfig().GetConfidentialNodes().GetEnabled() {
+		needUpdate = true
+		updateNodePoolRequest.ConfidentialNodes = desiredConfidentialNodes
+	}
+	// Upgrade settings
+	// The settings are sent along with a version change so the upgrade uses the desired strategy.
+	desiredUpgradeSettings := desiredNodePool.GetUpgradeSettings()
+	if desiredUpgradeSettings != nil && (updateNodePoolRequest.NodeVersion != "" || !compareUpgradeSettings(desiredUpgradeSettings, existingNodePool.GetUpgradeSettings())) {
+		needUpdate = true
+		updateNodePoolRequest.UpgradeSettings = desiredUpgradeSettings
+	}
+	// Resource manager tags
+	desiredResourceManagerTags := s.scope.GCPManagedMachinePool.Spec.ResourceManagerTags
+	if !cmp.Equal(desiredResourceManagerTags, existingNodePool.GetConfig().GetResourceManagerTags().GetTags(), cmpopts.EquateEmpty()) {
+		needUpdate = true
+		updateNodePoolRequest.ResourceManagerTags = &containerpb.ResourceManagerTags{
+			Tags: desiredResourceManagerTags,
+		}
+	}
 
 	return needUpdate, &updateNodePoolRequest
 }
 
+// compareKubeletConfig returns true if every kubelet setting specified in desired matches the existing node pool.
+// GKE leaves the settings that were never changed unset, so they are compared against the kubelet defaults.
+func compareKubeletConfig(desired, existing *containerpb.NodeKubeletConfig) bool {
+	if desired.GetCpuManagerPolicy() != "" {
+		existingPolicy := existing.GetCpuManagerPolicy()
+		if existingPolicy == "" {
+			existingPolicy = "none"
+		}
+		if desired.GetCpuManagerPolicy() != existingPolicy {
+			return false
+		}
+	}
+	if desired.GetCpuCfsQuota() != nil {
+		existingQuota := true
+		if existing.GetCpuCfsQuota() != nil {
+			existingQuota = existing.GetCpuCfsQuota().GetValue()
+		}
+		if desired.GetCpuCfsQuota().GetValue() != existingQuota {
+			return false
+		}
+	}
+	if desired.GetCpuCfsQuotaPeriod() != "" {
+		desiredPeriod, err := time.ParseDuration(desired.GetCpuCfsQuotaPeriod())
+		if err != nil {
+			return desired.GetCpuCfsQuotaPeriod() == existing.GetCpuCfsQuotaPeriod()
+		}
+		existingPeriod := 100 * time.Millisecond
+		if existing.GetCpuCfsQuotaPeriod() != "" {
+			existingPeriod, _ = time.ParseDuration(existing.GetCpuCfsQuotaPeriod())
+		}
+		if desiredPeriod != existingPeriod {
+			return false
+		}
+	}
+	if desired.GetPodPidsLimit() != 0 && desired.GetPodPidsLimit() != existing.GetPodPidsLimit() {
+		return false
+	}
+
+	return true
+}
+
 func (s *Service) checkDiffAndPrepareUpdateAutoscaling(existingNodePool *containerpb.NodePool) (bool, *containerpb.SetNodePoolAutoscalingRequest) {
 	needUpdate := false
 	desiredAutoscaling := infrav1exp.ConvertToSdkAutoscaling(s.scope.GCPManagedMachinePool.Spec.Scaling)
@@ -428,27 +607,36 @@ func (s *Service) checkDiffAndPrepareUpdateAutoscaling(existingNodePool *contain
 	return needUpdate, &setNodePoolAutoscalingRequest
 }
 
-func (s *Service) checkDiffAndPrepareUpdateSize(existingNodePool *containerpb.NodePool) (bool, *containerpb.SetNodePoolSizeRequest) {
+func (s *Service) checkDiffAndPrepareUpdateSize(existingNodePool *containerpb.NodePool) (bool, *containerpb.SetNodePoolSizeRequest, error) {
 	needUpdate := false
 	desiredAutoscaling := infrav1exp.ConvertToSdkAutoscaling(s.scope.GCPManagedMachinePool.Spec.Scaling)
 
 	if desiredAutoscaling.GetEnabled() {
 		// Do not update node pool size if autoscaling is enabled.
-		return false, nil
+		return false, nil, nil
 	}
 
 	setNodePoolSizeRequest := containerpb.SetNodePoolSizeRequest{
 		Name: s.scope.NodePoolFullName(),
 	}
 
-	replicas := *s.scope.MachinePool.Spec.Replicas
-	if shared.IsRegional(s.scope.Region()) {
-		replicas /= int32(len(existingNodePool.GetLocations()))
+	zones := int32(len(existingNodePool.GetLocations())) //nolint:gosec
+	replicas := ptr.Deref(s.scope.GCPManagedMachinePool.Spec.ReplicasPerZone, 0)
+	if s.scope.GCPManagedMachinePool.Spec.ReplicasPerZone != nil {
+		if err := shared.CheckReplicasPerZone(*s.scope.MachinePool.Spec.Replicas, replicas, zones); err != nil {
+			return false, nil, fmt.Errorf("node pool spread across %w", err)
+		}
+	} else {
+		var err error
+		replicas, err = shared.NodeCountPerZone(*s.scope.MachinePool.Spec.Replicas, zones)
+		if err != nil {
+			return false, nil, fmt.Errorf("node pool spread across %w", err)
+		}
 	}
 
 	if replicas != existingNodePool.GetInitialNodeCount() {
 		needUpdate = true
 		setNodePoolSizeRequest.NodeCount = replicas
 	}
-	return needUpdate, &setNodePoolSizeRequest
+	return needUpdate, &setNodePoolSizeRequest, nil
 }
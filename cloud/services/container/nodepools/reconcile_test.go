@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestAddZoneStatus(t *testing.T) {
+	running := &computepb.ManagedInstance{
+		InstanceStatus: ptr.To(computepb.ManagedInstance_RUNNING.String()),
+		CurrentAction:  ptr.To(computepb.ManagedInstance_NONE.String()),
+	}
+	creating := &computepb.ManagedInstance{
+		InstanceStatus: ptr.To(computepb.ManagedInstance_PROVISIONING.String()),
+		CurrentAction:  ptr.To(computepb.ManagedInstance_CREATING.String()),
+	}
+
+	var zones []infrav1exp.NodePoolZoneStatus
+	zones = addZoneStatus(zones, "us-central1-a", []*computepb.ManagedInstance{running, creating})
+	zones = addZoneStatus(zones, "us-central1-b", []*computepb.ManagedInstance{running})
+	zones = addZoneStatus(zones, "us-central1-a", []*computepb.ManagedInstance{running})
+
+	want := []infrav1exp.NodePoolZoneStatus{
+		{Zone: "us-central1-a", Replicas: 3, ReadyReplicas: 2},
+		{Zone: "us-central1-b", Replicas: 1, ReadyReplicas: 1},
+	}
+	if diff := cmp.Diff(want, zones); diff != "" {
+		t.Errorf("addZoneStatus() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"context"
+	"math"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+const (
+	// operationMetricNodesDone is the operation progress metric counting the nodes already upgraded.
+	operationMetricNodesDone = "NODES_DONE"
+	// operationMetricNodesTotal is the operation progress metric counting the nodes to upgrade.
+	operationMetricNodesTotal = "NODES_TOTAL"
+)
+
+// compareUpgradeSettings returns true if the upgrade settings of the existing node pool match the desired ones.
+// Blue-green settings that are not specified are left to the GKE defaults and are not compared.
+func compareUpgradeSettings(desired, existing *containerpb.NodePool_UpgradeSettings) bool {
+	if desired == nil {
+		return true
+	}
+
+	existingStrategy := existing.GetStrategy()
+	if existingStrategy == containerpb.NodePoolUpdateStrategy_NODE_POOL_UPDATE_STRATEGY_UNSPECIFIED {
+		existingStrategy = containerpb.NodePoolUpdateStrategy_SURGE
+	}
+	if desired.GetStrategy() != existingStrategy {
+		return false
+	}
+
+	if desired.GetStrategy() == containerpb.NodePoolUpdateStrategy_SURGE {
+		return desired.GetMaxSurge() == existing.GetMaxSurge() && desired.GetMaxUnavailable() == existing.GetMaxUnavailable()
+	}
+
+	desiredPolicy := desired.GetBlueGreenSettings().GetStandardRolloutPolicy()
+	existingPolicy := existing.GetBlueGreenSettings().GetStandardRolloutPolicy()
+	if desiredPolicy.GetUpdateBatchSize() != nil && (desiredPolicy.GetBatchNodeCount() != existingPolicy.GetBatchNodeCount() ||
+		desiredPolicy.GetBatchPercentage() != existingPolicy.GetBatchPercentage()) {
+		return false
+	}
+	if desiredPolicy.GetBatchSoakDuration() != nil &&
+		desiredPolicy.GetBatchSoakDuration().AsDuration() != existingPolicy.GetBatchSoakDuration().AsDuration() {
+		return false
+	}
+	desiredSoak := desired.GetBlueGreenSettings().GetNodePoolSoakDuration()
+	if desiredSoak != nil && desiredSoak.AsDuration() != existing.GetBlueGreenSettings().GetNodePoolSoakDuration().AsDuration() {
+		return false
+	}
+
+	return true
+}
+
+// newUpgradeStatus returns the status of an upgrade to targetVersion started by operation.
+func newUpgradeStatus(operation *containerpb.Operation, targetVersion string, upgradeSettings *containerpb.NodePool_UpgradeSettings) *infrav1exp.NodePoolUpgradeStatus {
+	strategy := infrav1exp.SurgeUpgradeStrategy
+	if upgradeSettings.GetStrategy() == containerpb.NodePoolUpdateStrategy_BLUE_GREEN {
+		strategy = infrav1exp.BlueGreenUpgradeStrategy
+	}
+	now := metav1.Now()
+
+	return &infrav1exp.NodePoolUpgradeStatus{
+		Strategy:      strategy,
+		Operation:     operation.GetName(),
+		TargetVersion: targetVersion,
+		StartTime:     &now,
+	}
+}
+
+// updateUpgradeStatus refreshes the progress of the node pool upgrade reported in status.
+func (s *Service) updateUpgradeStatus(ctx context.Context, nodePool *containerpb.NodePool) error {
+	upgrade := s.scope.GCPManagedMachinePool.Status.Upgrade
+	if upgrade == nil {
+		return nil
+	}
+
+	upgrade.Phase = ""
+	if phase := nodePool.GetUpdateInfo().GetBlueGreenInfo().GetPhase(); phase != containerpb.NodePool_UpdateInfo_BlueGreenInfo_PHASE_UNSPECIFIED {
+		upgrade.Phase = phase.String()
+	}
+
+	if upgrade.Operation == "" {
+		return nil
+	}
+	operation, err := s.scope.ManagedMachinePoolClient().GetOperation(ctx, &containerpb.GetOperationRequest{
+		Name: s.scope.OperationFullName(upgrade.Operation),
+	})
+	if err != nil {
+		return err
+	}
+	upgrade.NodesUpgraded, upgrade.NodesTotal = operationNodeProgress(operation.GetProgress())
+	upgrade.CurrentBatch = currentUpgradeBatch(nodePool.GetUpgradeSettings(), upgrade.NodesUpgraded, upgrade.NodesTotal)
+
+	return nil
+}
+
+// operationNodeProgress returns the number of nodes done and the total number of nodes reported by the progress of
+// an operation, looking into its stages when the operation itself does not report them.
+func operationNodeProgress(progress *containerpb.OperationProgress) (done, total int64) {
+	for _, metric := range progress.GetMetrics() {
+		switch metric.GetName() {
+		case operationMetricNodesDone:
+			done = metric.GetIntValue()
+		case operationMetricNodesTotal:
+			total = metric.GetIntValue()
+		}
+	}
+	if total > 0 {
+		return done, total
+	}
+
+	for _, stage := range progress.GetStages() {
+		if done, total = operationNodeProgress(stage); total > 0 {
+			return done, total
+		}
+	}
+
+	return 0, 0
+}
+
+// currentUpgradeBatch returns the batch of nodes being upgraded, starting at 1, or 0 if it can't be determined.
+func currentUpgradeBatch(upgradeSettings *containerpb.NodePool_UpgradeSettings, done, total int64) int32 {
+	if total <= 0 {
+		return 0
+	}
+
+	var batchSize int64
+	if upgradeSettings.GetStrategy() == containerpb.NodePoolUpdateStrategy_BLUE_GREEN {
+		policy := upgradeSettings.GetBlueGreenSettings().GetStandardRolloutPolicy()
+		switch {
+		case policy.GetBatchNodeCount() > 0:
+			batchSize = int64(policy.GetBatchNodeCount())
+		case policy.GetBatchPercentage() > 0:
+			batchSize = int64(math.Ceil(float64(total) * float64(policy.GetBatchPercentage())))
+		}
+	} else {
+		batchSize = int64(upgradeSettings.GetMaxSurge() + upgradeSettings.GetMaxUnavailable())
+	}
+	if batchSize <= 0 {
+		return 0
+	}
+
+	batches := (total + batchSize - 1) / batchSize
+	return int32(min(done/batchSize+1, batches)) //nolint:gosec
+}
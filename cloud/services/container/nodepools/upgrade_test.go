@@ -0,0 +1,174 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodepools
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestCompareUpgradeSettings(t *testing.T) {
+	blueGreen := containerpb.NodePoolUpdateStrategy_BLUE_GREEN
+	live := &containerpb.NodePool_UpgradeSettings{
+		Strategy: &blueGreen,
+		// GKE reports the surge settings even for blue-green upgrades.
+		MaxSurge: 1,
+		BlueGreenSettings: &containerpb.BlueGreenSettings{
+			RolloutPolicy: &containerpb.BlueGreenSettings_StandardRolloutPolicy_{
+				StandardRolloutPolicy: &containerpb.BlueGreenSettings_StandardRolloutPolicy{
+					UpdateBatchSize:   &containerpb.BlueGreenSettings_StandardRolloutPolicy_BatchNodeCount{BatchNodeCount: 2},
+					BatchSoakDuration: durationpb.New(0),
+				},
+			},
+			NodePoolSoakDuration: durationpb.New(time.Hour),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		desired *infrav1exp.NodePoolUpgradeSettings
+		live    *containerpb.NodePool_UpgradeSettings
+		want    bool
+	}{
+		{
+			name:    "not specified",
+			desired: nil,
+			live:    live,
+			want:    true,
+		},
+		{
+			name:    "default surge settings on a new node pool",
+			desired: &infrav1exp.NodePoolUpgradeSettings{Strategy: infrav1exp.SurgeUpgradeStrategy},
+			live:    &containerpb.NodePool_UpgradeSettings{MaxSurge: 1},
+			want:    true,
+		},
+		{
+			name:    "strategy changed",
+			desired: &infrav1exp.NodePoolUpgradeSettings{Strategy: infrav1exp.SurgeUpgradeStrategy},
+			live:    live,
+			want:    false,
+		},
+		{
+			name: "blue-green settings with GKE defaults",
+			desired: &infrav1exp.NodePoolUpgradeSettings{
+				Strategy:  infrav1exp.BlueGreenUpgradeStrategy,
+				BlueGreen: &infrav1exp.BlueGreenUpgradeSettings{BatchNodeCount: ptr.To(int32(2))},
+			},
+			live: live,
+			want: true,
+		},
+		{
+			name: "node pool soak duration changed",
+			desired: &infrav1exp.NodePoolUpgradeSettings{
+				Strategy: infrav1exp.BlueGreenUpgradeStrategy,
+				BlueGreen: &infrav1exp.BlueGreenUpgradeSettings{
+					NodePoolSoakDuration: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+			live: live,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareUpgradeSettings(infrav1exp.ConvertToSdkUpgradeSettings(tt.desired), tt.live); got != tt.want {
+				t.Errorf("compareUpgradeSettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrentUpgradeBatch(t *testing.T) {
+	blueGreen := containerpb.NodePoolUpdateStrategy_BLUE_GREEN
+	blueGreenPercentage := &containerpb.NodePool_UpgradeSettings{
+		Strategy: &blueGreen,
+		BlueGreenSettings: &containerpb.BlueGreenSettings{
+			RolloutPolicy: &containerpb.BlueGreenSettings_StandardRolloutPolicy_{
+				StandardRolloutPolicy: &containerpb.BlueGreenSettings_StandardRolloutPolicy{
+					UpdateBatchSize: &containerpb.BlueGreenSettings_StandardRolloutPolicy_BatchPercentage{BatchPercentage: 0.25},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		upgradeSettings *containerpb.NodePool_UpgradeSettings
+		done            int64
+		total           int64
+		want            int32
+	}{
+		{
+			name:            "surge first batch",
+			upgradeSettings: &containerpb.NodePool_UpgradeSettings{MaxSurge: 2, MaxUnavailable: 1},
+			done:            0,
+			total:           9,
+			want:            1,
+		},
+		{
+			name:            "surge last batch",
+			upgradeSettings: &containerpb.NodePool_UpgradeSettings{MaxSurge: 2, MaxUnavailable: 1},
+			done:            9,
+			total:           9,
+			want:            3,
+		},
+		{
+			name:            "blue-green percentage",
+			upgradeSettings: blueGreenPercentage,
+			done:            5,
+			total:           8,
+			want:            3,
+		},
+		{
+			name:            "unknown total",
+			upgradeSettings: &containerpb.NodePool_UpgradeSettings{MaxSurge: 1},
+			want:            0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currentUpgradeBatch(tt.upgradeSettings, tt.done, tt.total); got != tt.want {
+				t.Errorf("currentUpgradeBatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperationNodeProgress(t *testing.T) {
+	progress := &containerpb.OperationProgress{
+		Stages: []*containerpb.OperationProgress{
+			{
+				Name: "upgrade",
+				Metrics: []*containerpb.OperationProgress_Metric{
+					{Name: operationMetricNodesTotal, Value: &containerpb.OperationProgress_Metric_IntValue{IntValue: 6}},
+					{Name: operationMetricNodesDone, Value: &containerpb.OperationProgress_Metric_IntValue{IntValue: 4}},
+				},
+			},
+		},
+	}
+
+	done, total := operationNodeProgress(progress)
+	if done != 4 || total != 6 {
+		t.Errorf("operationNodeProgress() = %d, %d, want 4, 6", done, total)
+	}
+}
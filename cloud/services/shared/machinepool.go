@@ -33,16 +33,13 @@ func ManagedMachinePoolPreflightCheck(managedPool *infrav1exp.GCPManagedMachineP
 		return fmt.Errorf("expect machinepool infraref (%s) to match managed machine pool name (%s)", machinePool.Spec.Template.Spec.InfrastructureRef.Name, managedPool.Name)
 	}
 
-	if IsRegional(location) {
-		var numRegionsPerZone int32
-		if len(managedPool.Spec.NodeLocations) != 0 {
-			numRegionsPerZone = int32(len(managedPool.Spec.NodeLocations))
-		} else {
-			numRegionsPerZone = cloud.DefaultNumRegionsPerZone
-		}
-		if *machinePool.Spec.Replicas%numRegionsPerZone != 0 {
-			return fmt.Errorf("a machine pool (%s) in a regional cluster with %d regions, must have replicas with a multiple of %d", machinePool.Name, numRegionsPerZone, numRegionsPerZone)
+	zones := NodePoolZoneCount(managedPool, IsRegional(location))
+	if managedPool.Spec.ReplicasPerZone != nil {
+		if err := CheckReplicasPerZone(*machinePool.Spec.Replicas, *managedPool.Spec.ReplicasPerZone, zones); err != nil {
+			return fmt.Errorf("machine pool (%s) spread across %w", machinePool.Name, err)
 		}
+	} else if _, err := NodeCountPerZone(*machinePool.Spec.Replicas, zones); err != nil {
+		return fmt.Errorf("machine pool (%s) spread across %w", machinePool.Name, err)
 	}
 
 	return nil
@@ -66,6 +63,38 @@ func ManagedMachinePoolsPreflightCheck(managedPools []infrav1exp.GCPManagedMachi
 	return nil
 }
 
+// NodePoolZoneCount returns the number of zones the nodes of a node pool are spread across.
+func NodePoolZoneCount(managedPool *infrav1exp.GCPManagedMachinePool, regional bool) int32 {
+	if len(managedPool.Spec.NodeLocations) != 0 {
+		return int32(len(managedPool.Spec.NodeLocations)) //nolint:gosec
+	}
+	if regional {
+		return cloud.DefaultNumRegionsPerZone
+	}
+	return 1
+}
+
+// NodeCountPerZone returns the number of nodes in each zone for a node pool with the given total number of replicas.
+// It returns an error if the replicas can't be spread evenly across the zones.
+func NodeCountPerZone(replicas, zones int32) (int32, error) {
+	if zones <= 1 {
+		return replicas, nil
+	}
+	if replicas%zones != 0 {
+		return 0, fmt.Errorf("%d zones must have replicas with a multiple of %d, got %d", zones, zones, replicas)
+	}
+	return replicas / zones, nil
+}
+
+// CheckReplicasPerZone returns an error if the given total number of replicas doesn't match the number of replicas
+// per zone across all the zones of a node pool.
+func CheckReplicasPerZone(replicas, replicasPerZone, zones int32) error {
+	if replicas != replicasPerZone*zones {
+		return fmt.Errorf("%d zones with %d replicas per zone must have %d replicas, got %d", zones, replicasPerZone, replicasPerZone*zones, replicas)
+	}
+	return nil
+}
+
 // IsRegional will check if a given location is a region (if not its a zone).
 func IsRegional(location string) bool {
 	return strings.Count(location, "-") == 1
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1exp "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+)
+
+func TestManagedMachinePoolPreflightCheck(t *testing.T) {
+	tests := []struct {
+		name            string
+		location        string
+		replicas        int32
+		replicasPerZone *int32
+		wantErr         bool
+	}{
+		{
+			name:     "zonal cluster with any replicas",
+			location: "us-central1-a",
+			replicas: 2,
+		},
+		{
+			name:     "regional cluster with replicas spread evenly",
+			location: "us-central1",
+			replicas: 6,
+		},
+		{
+			name:     "regional cluster with replicas not spread evenly",
+			location: "us-central1",
+			replicas: 4,
+			wantErr:  true,
+		},
+		{
+			name:            "regional cluster with replicas matching replicas per zone",
+			location:        "us-central1",
+			replicas:        6,
+			replicasPerZone: ptr.To[int32](2),
+		},
+		{
+			name:            "regional cluster with replicas not matching replicas per zone",
+			location:        "us-central1",
+			replicas:        3,
+			replicasPerZone: ptr.To[int32](2),
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			managedPool := &infrav1exp.GCPManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec: infrav1exp.GCPManagedMachinePoolSpec{
+					ReplicasPerZone: tt.replicasPerZone,
+				},
+			}
+			machinePool := &clusterv1exp.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec: clusterv1exp.MachinePoolSpec{
+					Replicas: ptr.To(tt.replicas),
+				},
+			}
+			machinePool.Spec.Template.Spec.InfrastructureRef.Name = managedPool.Name
+			err := ManagedMachinePoolPreflightCheck(managedPool, machinePool, tt.location)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ManagedMachinePoolPreflightCheck() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/container/apiv1/containerpb"
+)
+
+// StatusConditionsMessage joins the messages of the conditions GKE reports on a cluster or node pool.
+func StatusConditionsMessage(statusConditions []*containerpb.StatusCondition) string {
+	messages := make([]string, 0, len(statusConditions))
+	for _, condition := range statusConditions {
+		if condition.GetMessage() == "" {
+			continue
+		}
+		if code := condition.GetCanonicalCode(); code != 0 {
+			messages = append(messages, fmt.Sprintf("%s: %s", code, condition.GetMessage()))
+			continue
+		}
+		messages = append(messages, condition.GetMessage())
+	}
+
+	return strings.Join(messages, "; ")
+}
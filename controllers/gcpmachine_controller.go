@@ -30,6 +30,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/predicates"
 	"sigs.k8s.io/cluster-api/util/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -215,6 +216,9 @@ func (r *GCPMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return r.reconcile(ctx, machineScope)
 }
 
+// preflightRequeueAfter is how long to wait before checking a GCPMachine that failed pre-flight checks again.
+const preflightRequeueAfter = time.Minute
+
 func (r *GCPMachineReconciler) reconcile(ctx context.Context, machineScope *scope.MachineScope) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 	log.Info("Reconciling GCPMachine")
@@ -224,6 +228,21 @@ func (r *GCPMachineReconciler) reconcile(ctx context.Context, machineScope *scop
 		return ctrl.Result{}, err
 	}
 
+	if !conditions.IsTrue(machineScope.GCPMachine, infrav1.PreflightChecksSucceededCondition) {
+		var preflightErr *instances.PreflightError
+		if err := instances.New(machineScope).Preflight(ctx); errors.As(err, &preflightErr) {
+			log.Info("GCPMachine failed pre-flight checks", "reason", err.Error())
+			record.Warnf(machineScope.GCPMachine, "GCPMachinePreflight", "Pre-flight checks failed - %v", err)
+			conditions.MarkFalse(machineScope.GCPMachine, infrav1.PreflightChecksSucceededCondition, infrav1.PreflightChecksFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return ctrl.Result{RequeueAfter: preflightRequeueAfter}, nil
+		} else if err != nil {
+			log.Error(err, "Error running pre-flight checks")
+			record.Warnf(machineScope.GCPMachine, "GCPMachinePreflight", "Pre-flight error - %v", err)
+			return ctrl.Result{}, err
+		}
+		conditions.MarkTrue(machineScope.GCPMachine, infrav1.PreflightChecksSucceededCondition)
+	}
+
 	if err := instances.New(machineScope).Reconcile(ctx); err != nil {
 		log.Error(err, "Error reconciling instance resources")
 		record.Warnf(machineScope.GCPMachine, "GCPMachineReconcile", "Reconcile error - %v", err)
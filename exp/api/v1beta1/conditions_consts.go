@@ -27,6 +27,9 @@ const (
 	GKEControlPlaneUpdatingCondition clusterv1.ConditionType = "GKEControlPlaneUpdating"
 	// GKEControlPlaneDeletingCondition condition reports on whether the GKE control plane is deleting.
 	GKEControlPlaneDeletingCondition clusterv1.ConditionType = "GKEControlPlaneDeleting"
+	// GKEControlPlaneVersionValidCondition condition reports on whether the desired version of the GKE control plane
+	// can be applied.
+	GKEControlPlaneVersionValidCondition clusterv1.ConditionType = "GKEControlPlaneVersionValid"
 
 	// GKEControlPlaneCreatingReason used to report GKE control plane being created.
 	GKEControlPlaneCreatingReason = "GKEControlPlaneCreating"
@@ -44,6 +47,20 @@ const (
 	GKEControlPlaneReconciliationFailedReason = "GKEControlPlaneReconciliationFailed"
 	// GKEControlPlaneRequiresAtLeastOneNodePoolReason used to report that no node pool is specified for the GKE control plane.
 	GKEControlPlaneRequiresAtLeastOneNodePoolReason = "GKEControlPlaneRequiresAtLeastOneNodePool"
+	// GKEControlPlaneSecurityPostureMismatchReason used to report that the security settings of the GKE control plane do not match the spec.
+	GKEControlPlaneSecurityPostureMismatchReason = "GKEControlPlaneSecurityPostureMismatch"
+	// GKEControlPlaneNotOwnedReason used to report that the GKE cluster exists but was not created by Cluster API.
+	GKEControlPlaneNotOwnedReason = "GKEControlPlaneNotOwned"
+	// GKEControlPlaneOperationFailedReason used to report that a GKE operation on the control plane failed.
+	GKEControlPlaneOperationFailedReason = "GKEControlPlaneOperationFailed"
+	// GKEControlPlaneVersionUnavailableReason used to report that the desired version is not available in the release channel.
+	GKEControlPlaneVersionUnavailableReason = "GKEControlPlaneVersionUnavailable"
+	// GKEControlPlaneVersionDowngradeReason used to report that the desired version is older than the current version.
+	GKEControlPlaneVersionDowngradeReason = "GKEControlPlaneVersionDowngrade"
+	// GKEControlPlaneVersionSkewReason used to report that the desired version would break the version skew with the node pools.
+	GKEControlPlaneVersionSkewReason = "GKEControlPlaneVersionSkew"
+	// GKEControlPlaneEndpointNotFoundReason used to report that the selected control plane endpoint does not exist on the GKE cluster.
+	GKEControlPlaneEndpointNotFoundReason = "GKEControlPlaneEndpointNotFound"
 
 	// GKEMachinePoolReadyCondition condition reports on the successful reconciliation of GKE node pool.
 	GKEMachinePoolReadyCondition clusterv1.ConditionType = "GKEMachinePoolReady"
@@ -70,4 +87,8 @@ const (
 	GKEMachinePoolErrorReason = "GKEMachinePoolError"
 	// GKEMachinePoolReconciliationFailedReason used to report failures while reconciling GKE node pool.
 	GKEMachinePoolReconciliationFailedReason = "GKEMachinePoolReconciliationFailed"
+	// GKEMachinePoolNotOwnedReason used to report that the GKE node pool exists but was not created by Cluster API.
+	GKEMachinePoolNotOwnedReason = "GKEMachinePoolNotOwned"
+	// GKEMachinePoolOperationFailedReason used to report that a GKE operation on the node pool failed.
+	GKEMachinePoolOperationFailedReason = "GKEMachinePoolOperationFailed"
 )
@@ -97,6 +97,16 @@ type GCPManagedCluster struct {
 	Status GCPManagedClusterStatus `json:"status,omitempty"`
 }
 
+// GetConditions returns the observations of the operational state of the GCPManagedCluster resource.
+func (r *GCPManagedCluster) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the GCPManagedCluster to the predescribed clusterv1.Conditions.
+func (r *GCPManagedCluster) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
 //+kubebuilder:object:root=true
 
 // GCPManagedClusterList contains a list of GCPManagedCluster.
@@ -18,7 +18,9 @@ package v1beta1
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/strings/slices"
@@ -66,6 +68,11 @@ type ClusterNetworkPod struct {
 	// This setting is permanent.
 	// +optional
 	CidrBlock string `json:"cidrBlock,omitempty"`
+
+	// SecondaryRangeName is the name of an existing secondary range of the cluster subnetwork to assign pod IP
+	// addresses from. Mutually exclusive with CidrBlock. Requires UseIPAliases. This setting is permanent.
+	// +optional
+	SecondaryRangeName string `json:"secondaryRangeName,omitempty"`
 }
 
 // ClusterNetworkService defines the range of CIDRBlock list from where it gets the IP address.
@@ -75,6 +82,60 @@ type ClusterNetworkService struct {
 	// This setting is permanent.
 	// +optional
 	CidrBlock string `json:"cidrBlock,omitempty"`
+
+	// SecondaryRangeName is the name of an existing secondary range of the cluster subnetwork to assign service
+	// IP addresses from. Mutually exclusive with CidrBlock. Requires UseIPAliases. This setting is permanent.
+	// +optional
+	SecondaryRangeName string `json:"secondaryRangeName,omitempty"`
+}
+
+// DatapathProvider is the dataplane used by the GKE cluster.
+// +kubebuilder:validation:Enum=LegacyDatapath;AdvancedDatapath
+type DatapathProvider string
+
+const (
+	// LegacyDatapath uses the IPTables based kube-proxy implementation.
+	LegacyDatapath DatapathProvider = "LegacyDatapath"
+	// AdvancedDatapath uses the eBPF based GKE Dataplane V2 with built-in network policy enforcement.
+	AdvancedDatapath DatapathProvider = "AdvancedDatapath"
+)
+
+// ClusterDNSProvider is the in-cluster DNS provider.
+// +kubebuilder:validation:Enum=PlatformDefault;CloudDNS;KubeDNS
+type ClusterDNSProvider string
+
+const (
+	// PlatformDefaultDNS uses the GKE default DNS provider.
+	PlatformDefaultDNS ClusterDNSProvider = "PlatformDefault"
+	// CloudDNS uses Cloud DNS for GKE.
+	CloudDNS ClusterDNSProvider = "CloudDNS"
+	// KubeDNS uses the kube-dns add-on.
+	KubeDNS ClusterDNSProvider = "KubeDNS"
+)
+
+// ClusterDNSScope is the scope of the Cloud DNS records of the cluster.
+// +kubebuilder:validation:Enum=ClusterScope;VPCScope
+type ClusterDNSScope string
+
+const (
+	// ClusterDNSScopeCluster makes the DNS records resolvable only from within the cluster.
+	ClusterDNSScopeCluster ClusterDNSScope = "ClusterScope"
+	// ClusterDNSScopeVPC makes the DNS records resolvable from the whole VPC network.
+	ClusterDNSScopeVPC ClusterDNSScope = "VPCScope"
+)
+
+// ClusterDNSConfig configures the in-cluster DNS.
+type ClusterDNSConfig struct {
+	// Provider is the in-cluster DNS provider.
+	Provider ClusterDNSProvider `json:"provider"`
+
+	// Scope is the scope of the DNS records. Only valid with the CloudDNS provider.
+	// +optional
+	Scope *ClusterDNSScope `json:"scope,omitempty"`
+
+	// Domain is the custom cluster DNS domain. Only valid with VPCScope.
+	// +optional
+	Domain string `json:"domain,omitempty"`
 }
 
 // ClusterNetwork define the cluster network.
@@ -95,13 +156,26 @@ type ClusterNetwork struct {
 	// Service defines the range of CIDRBlock list from where it gets the IP address.
 	// +optional
 	Service *ClusterNetworkService `json:"service,omitempty"`
+
+	// DatapathProvider selects the dataplane of the cluster. Defaults to LegacyDatapath for standard clusters,
+	// autopilot clusters always use AdvancedDatapath. This setting is permanent.
+	// +optional
+	DatapathProvider *DatapathProvider `json:"datapathProvider,omitempty"`
+
+	// DNSConfig configures the in-cluster DNS provider. The GKE default is used if not specified.
+	// +optional
+	DNSConfig *ClusterDNSConfig `json:"dnsConfig,omitempty"`
+
+	// EnableIntraNodeVisibility makes traffic between pods on the same node visible to the VPC network.
+	// +optional
+	EnableIntraNodeVisibility bool `json:"enableIntraNodeVisibility,omitempty"`
 }
 
 // WorkloadIdentityConfig allows workloads in your GKE clusters to impersonate Identity and Access Management (IAM)
 // service accounts to access Google Cloud services.
 type WorkloadIdentityConfig struct {
 	// WorkloadPool is the workload pool to attach all Kubernetes service accounts to Google Cloud services.
-	// Only relevant when enabled is true
+	// It must be of the form <project>.svc.id.goog.
 	// +kubebuilder:validation:Required
 	WorkloadPool string `json:"workloadPool,omitempty"`
 }
@@ -109,6 +183,7 @@ type WorkloadIdentityConfig struct {
 // AuthenticatorGroupConfig is RBAC security group for use with Google security groups in Kubernetes RBAC.
 type AuthenticatorGroupConfig struct {
 	// SecurityGroups is the name of the security group-of-groups to be used.
+	// It must be of the form gke-security-groups@<domain>.
 	// +kubebuilder:validation:Required
 	SecurityGroups string `json:"securityGroups,omitempty"`
 }
@@ -165,6 +240,487 @@ type GCPManagedControlPlaneSpec struct {
 	// Value is ignored when enableAutopilot = true.
 	// +optional
 	MonitoringService *MonitoringService `json:"monitoringService,omitempty"`
+	// LoggingConfig selects the components of the GKE cluster that send logs to Cloud Logging.
+	// Can't be set when loggingService is none.
+	// +optional
+	LoggingConfig *LoggingConfig `json:"loggingConfig,omitempty"`
+	// MonitoringConfig selects the components of the GKE cluster that send metrics to Cloud Monitoring
+	// and configures Managed Service for Prometheus and advanced datapath observability.
+	// Can't be set when monitoringService is none.
+	// +optional
+	MonitoringConfig *MonitoringConfig `json:"monitoringConfig,omitempty"`
+	// ResourceUsageExport exports the resource usage of the cluster to BigQuery, to attribute its costs per
+	// namespace and label. Not supported on autopilot clusters. The export is left unchanged when not set.
+	// +optional
+	ResourceUsageExport *ResourceUsageExportConfig `json:"resourceUsageExport,omitempty"`
+	// EnableCostAllocation enables GKE cost allocation, which breaks down the costs of the cluster by namespace
+	// and label in the Cloud Billing export. Left unchanged when not set.
+	// +optional
+	EnableCostAllocation *bool `json:"enableCostAllocation,omitempty"`
+	// WorkloadIdentityConfig allows workloads in the GKE cluster to impersonate IAM service accounts.
+	// Workload Identity is disabled if this field is not specified, except for autopilot clusters
+	// which always have it enabled.
+	// +optional
+	WorkloadIdentityConfig *WorkloadIdentityConfig `json:"workloadIdentityConfig,omitempty"`
+	// AuthenticatorGroupConfig configures Google Groups for RBAC in the GKE cluster.
+	// Google Groups for RBAC is disabled if this field is not specified.
+	// +optional
+	AuthenticatorGroupConfig *AuthenticatorGroupConfig `json:"authenticatorGroupConfig,omitempty"`
+	// MaintenancePolicy represents the maintenance windows and exclusions of the GKE cluster.
+	// GKE may perform maintenance at any time if this field is not specified.
+	// +optional
+	MaintenancePolicy *MaintenancePolicy `json:"maintenancePolicy,omitempty"`
+	// AddonsConfig represents the configuration of the GKE add-ons.
+	// Add-ons that are not specified keep the GKE defaults.
+	// +optional
+	AddonsConfig *AddonsConfig `json:"addonsConfig,omitempty"`
+	// BinaryAuthorization configures Binary Authorization enforcement of the GKE cluster.
+	// The current setting is kept if this field is not specified.
+	// +optional
+	BinaryAuthorization *BinaryAuthorization `json:"binaryAuthorization,omitempty"`
+	// EnableShieldedNodes indicates whether Shielded GKE Nodes are required for the GKE cluster.
+	// The GKE default (enabled) is kept if this field is not specified.
+	// +optional
+	EnableShieldedNodes *bool `json:"enableShieldedNodes,omitempty"`
+	// SecurityPosture configures the GKE security posture dashboard and workload vulnerability scanning.
+	// The current setting is kept if this field is not specified.
+	// +optional
+	SecurityPosture *SecurityPosture `json:"securityPosture,omitempty"`
+	// DatabaseEncryption configures application-layer encryption of Kubernetes secrets in etcd with a Cloud KMS key.
+	// The current setting is kept if this field is not specified.
+	// +optional
+	DatabaseEncryption *DatabaseEncryption `json:"databaseEncryption,omitempty"`
+	// ClusterAutoscaling configures the cluster autoscaler profile and node auto-provisioning of the GKE cluster.
+	// The current setting is kept if this field is not specified. Can't be set when enableAutopilot = true.
+	// +optional
+	ClusterAutoscaling *ClusterAutoscaling `json:"clusterAutoscaling,omitempty"`
+	// Kubeconfig configures how the kubeconfig secrets generated for the GKE cluster authenticate.
+	// +optional
+	Kubeconfig *KubeconfigConfig `json:"kubeconfig,omitempty"`
+	// DNSEndpoint configures the DNS-based endpoint of the control plane.
+	// +optional
+	DNSEndpoint *DNSEndpointConfig `json:"dnsEndpoint,omitempty"`
+	// EndpointType is the control plane endpoint used for the ControlPlaneEndpoint and the generated kubeconfigs.
+	// When not set, the endpoint reported by GKE is used, which is the public IP endpoint unless the private
+	// endpoint is enabled.
+	// +kubebuilder:validation:Enum=PublicIP;PrivateIP;DNS
+	// +optional
+	EndpointType *ControlPlaneEndpointType `json:"endpointType,omitempty"`
+	// Autopilot configures the autopilot cluster. Can only be set when enableAutopilot is true.
+	// +optional
+	Autopilot *AutopilotConfig `json:"autopilot,omitempty"`
+	// Import adopts an existing GKE cluster named ClusterName instead of failing when one exists. Unset fields of
+	// the spec that would otherwise change the cluster, such as the release channel and authorized networks, are
+	// populated from the live cluster when it is adopted.
+	// +optional
+	Import bool `json:"import,omitempty"`
+	// AllowUnownedDeletion allows deleting the GKE cluster when it was not created by Cluster API, such as an
+	// imported cluster. Otherwise the GKE cluster is left in place when the GCPManagedControlPlane is deleted.
+	// +optional
+	AllowUnownedDeletion bool `json:"allowUnownedDeletion,omitempty"`
+	// Fleet registers the cluster to a fleet through the GKE Hub API and configures the fleet features
+	// enabled on its membership. A membership registered by Cluster API is unregistered when the GCPManagedControlPlane
+	// and its cluster are deleted, an existing membership is adopted and left in place.
+	// +optional
+	Fleet *FleetConfig `json:"fleet,omitempty"`
+}
+
+// FleetConfig configures the fleet membership of the cluster.
+type FleetConfig struct {
+	// Project is the fleet host project. Defaults to the project of the cluster.
+	// +optional
+	Project string `json:"project,omitempty"`
+	// MembershipName is the name of the fleet membership. Defaults to the name of the GKE cluster.
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]{0,61}[a-z0-9])?$`
+	// +optional
+	MembershipName string `json:"membershipName,omitempty"`
+	// ConfigSync configures Config Sync on the membership. The configmanagement fleet feature is enabled
+	// on the fleet host project when set.
+	// +optional
+	ConfigSync *ConfigSyncConfig `json:"configSync,omitempty"`
+	// PolicyController configures Policy Controller on the membership. The policycontroller fleet feature
+	// is enabled on the fleet host project when set.
+	// +optional
+	PolicyController *PolicyControllerConfig `json:"policyController,omitempty"`
+}
+
+// ConfigSyncConfig configures Config Sync on a fleet membership.
+type ConfigSyncConfig struct {
+	// Version is the version of Config Sync to install. Defaults to the latest version.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// SourceFormat is the format of the synced repository.
+	// +kubebuilder:validation:Enum=hierarchy;unstructured
+	// +optional
+	SourceFormat string `json:"sourceFormat,omitempty"`
+	// PreventDrift enables the Config Sync admission webhook to prevent drift from the synced configuration.
+	// +optional
+	PreventDrift bool `json:"preventDrift,omitempty"`
+	// Git is the Git repository synced to the cluster.
+	Git ConfigSyncGitConfig `json:"git"`
+}
+
+// ConfigSyncGitConfig configures the Git repository synced by Config Sync.
+type ConfigSyncGitConfig struct {
+	// SyncRepo is the URL of the Git repository.
+	// +kubebuilder:validation:MinLength=1
+	SyncRepo string `json:"syncRepo"`
+	// SyncBranch is the branch of the repository to sync from. Defaults to master.
+	// +optional
+	SyncBranch string `json:"syncBranch,omitempty"`
+	// SyncRev is the revision to sync from. Defaults to HEAD.
+	// +optional
+	SyncRev string `json:"syncRev,omitempty"`
+	// PolicyDir is the path within the repository to the synced configuration. Defaults to the root.
+	// +optional
+	PolicyDir string `json:"policyDir,omitempty"`
+	// SecretType is the type of secret used to access the repository.
+	// +kubebuilder:validation:Enum=none;ssh;cookiefile;token;gcenode;gcpserviceaccount
+	// +kubebuilder:default=none
+	// +optional
+	SecretType string `json:"secretType,omitempty"`
+	// GCPServiceAccountEmail is the Google service account used to access the repository when the
+	// secret type is gcpserviceaccount.
+	// +optional
+	GCPServiceAccountEmail string `json:"gcpServiceAccountEmail,omitempty"`
+}
+
+// PolicyControllerConfig configures Policy Controller on a fleet membership.
+type PolicyControllerConfig struct {
+	// Version is the version of Policy Controller to install. Defaults to the latest version.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// AuditIntervalSeconds is the interval between audit runs. Audit is disabled when set to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	AuditIntervalSeconds *int64 `json:"auditIntervalSeconds,omitempty"`
+	// ExemptableNamespaces are the namespaces exempt from Policy Controller checks.
+	// +optional
+	ExemptableNamespaces []string `json:"exemptableNamespaces,omitempty"`
+	// LogDeniesEnabled logs all denies and dry run failures.
+	// +optional
+	LogDeniesEnabled bool `json:"logDeniesEnabled,omitempty"`
+	// MutationEnabled enables mutation support.
+	// +optional
+	MutationEnabled bool `json:"mutationEnabled,omitempty"`
+	// ReferentialRulesEnabled enables constraint templates that reference objects other than the object
+	// being evaluated.
+	// +optional
+	ReferentialRulesEnabled bool `json:"referentialRulesEnabled,omitempty"`
+	// TemplateLibraryDisabled skips the installation of the default constraint template library.
+	// +optional
+	TemplateLibraryDisabled bool `json:"templateLibraryDisabled,omitempty"`
+}
+
+// AutopilotConfig configures an autopilot cluster.
+type AutopilotConfig struct {
+	// WorkloadPolicies configures the workload policies enforced on the cluster.
+	// +optional
+	WorkloadPolicies *AutopilotWorkloadPolicies `json:"workloadPolicies,omitempty"`
+	// NodeServiceAccount is the Google service account used by the nodes. The Compute Engine default service
+	// account is used when not set. This setting is permanent.
+	// +optional
+	NodeServiceAccount string `json:"nodeServiceAccount,omitempty"`
+	// ResourceManagerTags are the resource manager tags bound to the nodes. Keys are in the form
+	// tagKeys/{tag_key_id} and values in the form tagValues/{tag_value_id}.
+	// +optional
+	ResourceManagerTags map[string]string `json:"resourceManagerTags,omitempty"`
+}
+
+// AutopilotWorkloadPolicies configures the workload policies enforced on an autopilot cluster.
+type AutopilotWorkloadPolicies struct {
+	// AllowNetAdmin allows workloads to use the NET_ADMIN capability.
+	// +optional
+	AllowNetAdmin bool `json:"allowNetAdmin,omitempty"`
+}
+
+// DNSEndpointConfig configures the DNS-based endpoint of the control plane.
+type DNSEndpointConfig struct {
+	// AllowExternalTraffic allows user traffic over the DNS-based endpoint, from anywhere IAM permits.
+	// +optional
+	AllowExternalTraffic bool `json:"allowExternalTraffic,omitempty"`
+}
+
+// ControlPlaneEndpointType is an endpoint of the control plane.
+type ControlPlaneEndpointType string
+
+const (
+	// ControlPlaneEndpointPublicIP is the public IP endpoint of the control plane.
+	ControlPlaneEndpointPublicIP ControlPlaneEndpointType = "PublicIP"
+	// ControlPlaneEndpointPrivateIP is the private IP endpoint of the control plane.
+	ControlPlaneEndpointPrivateIP ControlPlaneEndpointType = "PrivateIP"
+	// ControlPlaneEndpointDNS is the DNS-based endpoint of the control plane.
+	ControlPlaneEndpointDNS ControlPlaneEndpointType = "DNS"
+)
+
+// KubeconfigAuthMode is the way a generated kubeconfig authenticates to the GKE cluster.
+type KubeconfigAuthMode string
+
+const (
+	// KubeconfigExecPlugin uses gke-gcloud-auth-plugin to get credentials of the user running kubectl.
+	KubeconfigExecPlugin KubeconfigAuthMode = "ExecPlugin"
+	// KubeconfigTokenRefresh embeds a short-lived access token of the controller service account, which
+	// is rotated before it expires.
+	KubeconfigTokenRefresh KubeconfigAuthMode = "TokenRefresh"
+	// KubeconfigServiceAccountToken embeds a short-lived token of a Kubernetes service account created for
+	// Cluster API in the GKE cluster, which is rotated before it expires.
+	KubeconfigServiceAccountToken KubeconfigAuthMode = "ServiceAccountToken"
+)
+
+const (
+	// DefaultServiceAccountTokenTTL is the default lifetime of the Kubernetes service account tokens used in kubeconfigs.
+	DefaultServiceAccountTokenTTL = time.Hour
+	// MinServiceAccountTokenTTL is the minimum lifetime of the Kubernetes service account tokens used in kubeconfigs.
+	MinServiceAccountTokenTTL = 20 * time.Minute
+)
+
+// KubeconfigConfig configures how the kubeconfig secrets generated for the GKE cluster authenticate.
+type KubeconfigConfig struct {
+	// CAPIAuthMode is the way the kubeconfig used by Cluster API authenticates.
+	// +kubebuilder:validation:Enum=TokenRefresh;ServiceAccountToken
+	// +kubebuilder:default=TokenRefresh
+	// +optional
+	CAPIAuthMode KubeconfigAuthMode `json:"capiAuthMode,omitempty"`
+	// UserAuthMode is the way the kubeconfig for users, stored in the <cluster>-user-kubeconfig secret, authenticates.
+	// +kubebuilder:validation:Enum=ExecPlugin;TokenRefresh
+	// +kubebuilder:default=ExecPlugin
+	// +optional
+	UserAuthMode KubeconfigAuthMode `json:"userAuthMode,omitempty"`
+	// ServiceAccountTokenTTL is the lifetime of the Kubernetes service account tokens. Defaults to 1h.
+	// +optional
+	ServiceAccountTokenTTL *metav1.Duration `json:"serviceAccountTokenTTL,omitempty"`
+}
+
+// AutoscalingProfile is the cluster autoscaler profile.
+// +kubebuilder:validation:Enum=Balanced;OptimizeUtilization
+type AutoscalingProfile string
+
+const (
+	// AutoscalingProfileBalanced is the default profile of the cluster autoscaler.
+	AutoscalingProfileBalanced AutoscalingProfile = "Balanced"
+	// AutoscalingProfileOptimizeUtilization prioritizes the utilization of the nodes over keeping spare capacity.
+	AutoscalingProfileOptimizeUtilization AutoscalingProfile = "OptimizeUtilization"
+)
+
+// ClusterAutoscaling configures the cluster autoscaler and node auto-provisioning.
+type ClusterAutoscaling struct {
+	// AutoscalingProfile is the profile of the cluster autoscaler.
+	// +optional
+	AutoscalingProfile *AutoscalingProfile `json:"autoscalingProfile,omitempty"`
+	// EnableNodeAutoprovisioning enables node auto-provisioning, which creates and deletes node pools
+	// based on the pending workloads. Requires resource limits for cpu and memory.
+	// +optional
+	EnableNodeAutoprovisioning bool `json:"enableNodeAutoprovisioning,omitempty"`
+	// ResourceLimits limit the total resources of the nodes created by node auto-provisioning.
+	// +listType=map
+	// +listMapKey=resourceType
+	// +optional
+	ResourceLimits []ResourceLimit `json:"resourceLimits,omitempty"`
+	// AutoprovisioningLocations are the zones in which node auto-provisioning can create node pools.
+	// +optional
+	AutoprovisioningLocations []string `json:"autoprovisioningLocations,omitempty"`
+	// AutoprovisioningNodePoolDefaults are the defaults of the node pools created by node auto-provisioning.
+	// +optional
+	AutoprovisioningNodePoolDefaults *AutoprovisioningNodePoolDefaults `json:"autoprovisioningNodePoolDefaults,omitempty"`
+}
+
+// ResourceLimit limits the total amount of a resource in the GKE cluster.
+type ResourceLimit struct {
+	// ResourceType is the resource to limit: cpu, memory (in GB) or a GPU type such as nvidia-tesla-t4.
+	ResourceType string `json:"resourceType"`
+	// Minimum is the minimum amount of the resource in the cluster.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	Minimum int64 `json:"minimum,omitempty"`
+	// Maximum is the maximum amount of the resource in the cluster.
+	// +kubebuilder:validation:Minimum:=0
+	Maximum int64 `json:"maximum"`
+}
+
+// AutoprovisioningNodePoolDefaults are the defaults of the node pools created by node auto-provisioning.
+type AutoprovisioningNodePoolDefaults struct {
+	// ServiceAccount is the email of the Google Cloud service account used by the nodes.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+	// OAuthScopes are the Google API scopes available on the nodes.
+	// +optional
+	OAuthScopes []string `json:"oauthScopes,omitempty"`
+	// ImageType is the image type of the nodes.
+	// +optional
+	ImageType *string `json:"imageType,omitempty"`
+	// DiskSizeGb is the size of the boot disk of the nodes, in GB.
+	// +kubebuilder:validation:Minimum:=10
+	// +optional
+	DiskSizeGb *int32 `json:"diskSizeGb,omitempty"`
+	// DiskType is the type of the boot disk of the nodes.
+	// +optional
+	DiskType *DiskType `json:"diskType,omitempty"`
+	// BootDiskKMSKey is the Cloud KMS key used to encrypt the boot disk of the nodes, in the form
+	// projects/[KEY_PROJECT_ID]/locations/[LOCATION]/keyRings/[RING_NAME]/cryptoKeys/[KEY_NAME].
+	// +optional
+	BootDiskKMSKey *string `json:"bootDiskKMSKey,omitempty"`
+}
+
+// BinaryAuthorizationEvaluationMode is the Binary Authorization evaluation mode.
+// +kubebuilder:validation:Enum=Disabled;ProjectSingletonPolicyEnforce
+type BinaryAuthorizationEvaluationMode string
+
+const (
+	// BinaryAuthorizationDisabled disables Binary Authorization.
+	BinaryAuthorizationDisabled BinaryAuthorizationEvaluationMode = "Disabled"
+	// BinaryAuthorizationProjectSingletonPolicyEnforce enforces the Binary Authorization policy of the project.
+	BinaryAuthorizationProjectSingletonPolicyEnforce BinaryAuthorizationEvaluationMode = "ProjectSingletonPolicyEnforce"
+)
+
+// BinaryAuthorization configures Binary Authorization.
+type BinaryAuthorization struct {
+	// EvaluationMode is the Binary Authorization evaluation mode.
+	EvaluationMode BinaryAuthorizationEvaluationMode `json:"evaluationMode"`
+}
+
+// SecurityPostureMode is the mode of the GKE security posture features.
+// +kubebuilder:validation:Enum=Disabled;Basic;Enterprise
+type SecurityPostureMode string
+
+const (
+	// SecurityPostureDisabled disables the feature.
+	SecurityPostureDisabled SecurityPostureMode = "Disabled"
+	// SecurityPostureBasic enables the standard tier of the feature.
+	SecurityPostureBasic SecurityPostureMode = "Basic"
+	// SecurityPostureEnterprise enables the enterprise tier of the feature.
+	SecurityPostureEnterprise SecurityPostureMode = "Enterprise"
+)
+
+// SecurityPosture configures the GKE security posture features.
+type SecurityPosture struct {
+	// Mode is the mode of the security posture dashboard.
+	// +optional
+	Mode *SecurityPostureMode `json:"mode,omitempty"`
+	// VulnerabilityMode is the mode of workload vulnerability scanning.
+	// +optional
+	VulnerabilityMode *SecurityPostureMode `json:"vulnerabilityMode,omitempty"`
+}
+
+// DatabaseEncryption configures application-layer secrets encryption.
+type DatabaseEncryption struct {
+	// KeyName is the Cloud KMS key used to encrypt secrets, in the form
+	// projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>. The key must be in the region of
+	// the cluster. Secrets are decrypted when empty.
+	// +optional
+	KeyName string `json:"keyName,omitempty"`
+}
+
+// AddonsConfig enables or disables the GKE add-ons. Each add-on is enabled when set to true, disabled when
+// set to false and left to GKE when not set.
+type AddonsConfig struct {
+	// HTTPLoadBalancing enables the HTTP (L7) load balancing controller used by Ingress.
+	// +optional
+	HTTPLoadBalancing *bool `json:"httpLoadBalancing,omitempty"`
+	// HorizontalPodAutoscaling enables the metrics pipeline used by the HorizontalPodAutoscaler.
+	// +optional
+	HorizontalPodAutoscaling *bool `json:"horizontalPodAutoscaling,omitempty"`
+	// NetworkPolicy enables Calico based network policy enforcement.
+	// +optional
+	NetworkPolicy *bool `json:"networkPolicy,omitempty"`
+	// GcePersistentDiskCsiDriver enables the Compute Engine persistent disk CSI driver.
+	// +optional
+	GcePersistentDiskCsiDriver *bool `json:"gcePersistentDiskCsiDriver,omitempty"`
+	// GcpFilestoreCsiDriver enables the Filestore CSI driver.
+	// +optional
+	GcpFilestoreCsiDriver *bool `json:"gcpFilestoreCsiDriver,omitempty"`
+	// GcsFuseCsiDriver enables the Cloud Storage FUSE CSI driver.
+	// +optional
+	GcsFuseCsiDriver *bool `json:"gcsFuseCsiDriver,omitempty"`
+	// NodeLocalDNS enables the NodeLocal DNSCache.
+	// +optional
+	NodeLocalDNS *bool `json:"nodeLocalDNS,omitempty"`
+	// ConfigConnector enables Config Connector. It requires Workload Identity.
+	// +optional
+	ConfigConnector *bool `json:"configConnector,omitempty"`
+	// GkeBackupAgent enables the Backup for GKE agent.
+	// +optional
+	GkeBackupAgent *bool `json:"gkeBackupAgent,omitempty"`
+	// SecretManager enables the Secret Manager add-on, which exposes Secret Manager secrets to workloads as volumes.
+	// +optional
+	SecretManager *bool `json:"secretManager,omitempty"`
+}
+
+// MaintenancePolicy defines when GKE is allowed to perform automatic maintenance on the cluster.
+type MaintenancePolicy struct {
+	// DailyMaintenanceWindow allows maintenance to start every day at the given time.
+	// Mutually exclusive with RecurringWindow.
+	// +optional
+	DailyMaintenanceWindow *DailyMaintenanceWindow `json:"dailyMaintenanceWindow,omitempty"`
+	// RecurringWindow allows maintenance during a window that repeats according to an RRULE.
+	// Mutually exclusive with DailyMaintenanceWindow.
+	// +optional
+	RecurringWindow *RecurringMaintenanceWindow `json:"recurringWindow,omitempty"`
+	// MaintenanceExclusions are periods during which automatic maintenance is restricted.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	MaintenanceExclusions []MaintenanceExclusion `json:"maintenanceExclusions,omitempty"`
+}
+
+// DailyMaintenanceWindow is a maintenance window that starts every day.
+type DailyMaintenanceWindow struct {
+	// StartTime is the time the maintenance window starts, in the "HH:MM" format (GMT).
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+}
+
+// RecurringMaintenanceWindow is a maintenance window that repeats according to an RRULE.
+type RecurringMaintenanceWindow struct {
+	// StartTime is the start of the first occurrence of the window.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the end of the first occurrence of the window.
+	EndTime metav1.Time `json:"endTime"`
+	// Recurrence is an RFC 5545 RRULE for how the window recurs, for example
+	// FREQ=WEEKLY;BYDAY=SA,SU.
+	// +kubebuilder:validation:MinLength=1
+	Recurrence string `json:"recurrence"`
+}
+
+// MaintenanceExclusionScope is the kind of maintenance that is blocked during a maintenance exclusion.
+// +kubebuilder:validation:Enum=NoUpgrades;NoMinorUpgrades;NoMinorOrNodeUpgrades
+type MaintenanceExclusionScope string
+
+const (
+	// NoUpgrades blocks all upgrades, including patch upgrades and node upgrades.
+	NoUpgrades MaintenanceExclusionScope = "NoUpgrades"
+	// NoMinorUpgrades blocks minor version upgrades of the control plane and nodes.
+	NoMinorUpgrades MaintenanceExclusionScope = "NoMinorUpgrades"
+	// NoMinorOrNodeUpgrades blocks minor version upgrades and all node upgrades.
+	NoMinorOrNodeUpgrades MaintenanceExclusionScope = "NoMinorOrNodeUpgrades"
+)
+
+// MaintenanceExclusion is a named period during which automatic maintenance is restricted.
+type MaintenanceExclusion struct {
+	// Name is the name of the exclusion.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// StartTime is the start of the exclusion.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the end of the exclusion.
+	EndTime metav1.Time `json:"endTime"`
+	// Scope is the kind of maintenance blocked by the exclusion.
+	// Defaults to NoUpgrades.
+	// +optional
+	Scope *MaintenanceExclusionScope `json:"scope,omitempty"`
+}
+
+// MaintenancePolicyStatus shows the maintenance policy in effect on the GKE cluster.
+type MaintenancePolicyStatus struct {
+	// Window describes the maintenance window in effect.
+	// +optional
+	Window string `json:"window,omitempty"`
+	// ActiveExclusions lists the names of the maintenance exclusions currently in effect.
+	// +optional
+	ActiveExclusions []string `json:"activeExclusions,omitempty"`
+	// NextMaintenanceTime is the start of the next maintenance window that is not blocked by a
+	// NoUpgrades exclusion. It is not set when the window recurrence cannot be evaluated.
+	// +optional
+	NextMaintenanceTime *metav1.Time `json:"nextMaintenanceTime,omitempty"`
 }
 
 // GCPManagedControlPlaneStatus defines the observed state of GCPManagedControlPlane.
@@ -185,6 +741,36 @@ type GCPManagedControlPlaneStatus struct {
 	// CurrentVersion shows the current version of the GKE control plane.
 	// +optional
 	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// AllowedVersions are the versions the GKE control plane can be upgraded to. They are available in the release
+	// channel of the cluster, are not downgrades and keep the node pools within the supported version skew.
+	// +optional
+	AllowedVersions []string `json:"allowedVersions,omitempty"`
+
+	// MaintenancePolicy shows the maintenance window and exclusions in effect on the GKE cluster.
+	// +optional
+	MaintenancePolicy *MaintenancePolicyStatus `json:"maintenancePolicy,omitempty"`
+
+	// Imported is true once an existing GKE cluster has been adopted and the spec populated from it.
+	// +optional
+	Imported bool `json:"imported,omitempty"`
+
+	// FleetMembership is the full resource name of the fleet membership of the cluster.
+	// +optional
+	FleetMembership string `json:"fleetMembership,omitempty"`
+
+	// FleetMembershipCreated is true when the fleet membership was registered by Cluster API. Memberships that
+	// already existed are adopted but left in place when the cluster is unregistered or deleted.
+	// +optional
+	FleetMembershipCreated bool `json:"fleetMembershipCreated,omitempty"`
+
+	// Operation is the last GKE operation started on the cluster.
+	// +optional
+	Operation *GKEOperation `json:"operation,omitempty"`
+
+	// GKEConditions are the conditions reported by GKE on the cluster, which explain an error or degraded state.
+	// +optional
+	GKEConditions []GKEStatusCondition `json:"gkeConditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -268,6 +854,102 @@ func (l LoggingService) String() string {
 	return string(l)
 }
 
+// LoggingComponent is a component of the GKE cluster that can send logs to Cloud Logging.
+// +kubebuilder:validation:Enum=SystemComponents;Workloads;APIServer;Scheduler;ControllerManager
+type LoggingComponent string
+
+const (
+	// LoggingComponentSystemComponents collects logs of the system components.
+	LoggingComponentSystemComponents LoggingComponent = "SystemComponents"
+	// LoggingComponentWorkloads collects logs of the workloads.
+	LoggingComponentWorkloads LoggingComponent = "Workloads"
+	// LoggingComponentAPIServer collects logs of the kube-apiserver.
+	LoggingComponentAPIServer LoggingComponent = "APIServer"
+	// LoggingComponentScheduler collects logs of the kube-scheduler.
+	LoggingComponentScheduler LoggingComponent = "Scheduler"
+	// LoggingComponentControllerManager collects logs of the kube-controller-manager.
+	LoggingComponentControllerManager LoggingComponent = "ControllerManager"
+)
+
+// LoggingConfig is the Cloud Logging configuration of the GKE cluster.
+type LoggingConfig struct {
+	// EnableComponents are the components that send logs. SystemComponents must be included.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	EnableComponents []LoggingComponent `json:"enableComponents"`
+}
+
+// MonitoringComponent is a component of the GKE cluster that can send metrics to Cloud Monitoring.
+// +kubebuilder:validation:Enum=SystemComponents;APIServer;Scheduler;ControllerManager;Storage;HPA;Pod;DaemonSet;Deployment;StatefulSet;CAdvisor;Kubelet
+type MonitoringComponent string
+
+const (
+	// MonitoringComponentSystemComponents collects metrics of the system components.
+	MonitoringComponentSystemComponents MonitoringComponent = "SystemComponents"
+	// MonitoringComponentAPIServer collects metrics of the kube-apiserver.
+	MonitoringComponentAPIServer MonitoringComponent = "APIServer"
+	// MonitoringComponentScheduler collects metrics of the kube-scheduler.
+	MonitoringComponentScheduler MonitoringComponent = "Scheduler"
+	// MonitoringComponentControllerManager collects metrics of the kube-controller-manager.
+	MonitoringComponentControllerManager MonitoringComponent = "ControllerManager"
+	// MonitoringComponentStorage collects persistent volume metrics.
+	MonitoringComponentStorage MonitoringComponent = "Storage"
+	// MonitoringComponentHPA collects HorizontalPodAutoscaler metrics.
+	MonitoringComponentHPA MonitoringComponent = "HPA"
+	// MonitoringComponentPod collects pod metrics.
+	MonitoringComponentPod MonitoringComponent = "Pod"
+	// MonitoringComponentDaemonSet collects DaemonSet metrics.
+	MonitoringComponentDaemonSet MonitoringComponent = "DaemonSet"
+	// MonitoringComponentDeployment collects Deployment metrics.
+	MonitoringComponentDeployment MonitoringComponent = "Deployment"
+	// MonitoringComponentStatefulSet collects StatefulSet metrics.
+	MonitoringComponentStatefulSet MonitoringComponent = "StatefulSet"
+	// MonitoringComponentCAdvisor collects cAdvisor metrics.
+	MonitoringComponentCAdvisor MonitoringComponent = "CAdvisor"
+	// MonitoringComponentKubelet collects kubelet metrics.
+	MonitoringComponentKubelet MonitoringComponent = "Kubelet"
+)
+
+// ResourceUsageExportConfig configures the export of the resource usage of a cluster to BigQuery.
+type ResourceUsageExportConfig struct {
+	// BigQueryDatasetID is the ID of the BigQuery dataset the resource usage is exported to.
+	// +kubebuilder:validation:MinLength=1
+	BigQueryDatasetID string `json:"bigQueryDatasetID"`
+	// EnableNetworkEgressMetering exports the network egress of the cluster. This deploys a metering agent on
+	// the nodes.
+	// +optional
+	EnableNetworkEgressMetering bool `json:"enableNetworkEgressMetering,omitempty"`
+	// EnableConsumptionMetering exports the resource consumption of the pods in addition to their requests.
+	// +optional
+	EnableConsumptionMetering bool `json:"enableConsumptionMetering,omitempty"`
+}
+
+// MonitoringConfig is the Cloud Monitoring configuration of the GKE cluster.
+type MonitoringConfig struct {
+	// EnableComponents are the components that send metrics. SystemComponents must be included.
+	// The current components are kept if not specified.
+	// +optional
+	// +listType=set
+	EnableComponents []MonitoringComponent `json:"enableComponents,omitempty"`
+	// EnableManagedPrometheus indicates whether Google Cloud Managed Service for Prometheus is enabled.
+	// +optional
+	EnableManagedPrometheus *bool `json:"enableManagedPrometheus,omitempty"`
+	// AdvancedDatapathObservability configures the observability of GKE Dataplane V2.
+	// Requires the AdvancedDatapath datapath provider.
+	// +optional
+	AdvancedDatapathObservability *AdvancedDatapathObservability `json:"advancedDatapathObservability,omitempty"`
+}
+
+// AdvancedDatapathObservability configures the observability of GKE Dataplane V2.
+type AdvancedDatapathObservability struct {
+	// EnableMetrics enables the collection of Dataplane V2 metrics.
+	// +optional
+	EnableMetrics bool `json:"enableMetrics,omitempty"`
+	// EnableRelay enables the Hubble Relay service.
+	// +optional
+	EnableRelay bool `json:"enableRelay,omitempty"`
+}
+
 // MonitoringService is GKE logging service configuration.
 type MonitoringService string
 
@@ -286,6 +968,28 @@ func (m MonitoringService) String() string {
 	return string(m)
 }
 
+// workloadPoolRegexp matches the <project>.svc.id.goog workload pool of a GCP project.
+var workloadPoolRegexp = regexp.MustCompile(`^[a-z][-a-z0-9.:]{4,61}[a-z0-9]\.svc\.id\.goog$`)
+
+// Validate validates WorkloadIdentityConfig value.
+func (w *WorkloadIdentityConfig) Validate() error {
+	if !workloadPoolRegexp.MatchString(w.WorkloadPool) {
+		return fmt.Errorf("invalid workload pool %q; expect <project>.svc.id.goog", w.WorkloadPool)
+	}
+
+	return nil
+}
+
+// Validate validates AuthenticatorGroupConfig value.
+func (a *AuthenticatorGroupConfig) Validate() error {
+	domain, found := strings.CutPrefix(a.SecurityGroups, "gke-security-groups@")
+	if !found || domain == "" || strings.Contains(domain, "@") {
+		return fmt.Errorf("invalid security group %q; expect gke-security-groups@<domain>", a.SecurityGroups)
+	}
+
+	return nil
+}
+
 // GetConditions returns the control planes conditions.
 func (r *GCPManagedControlPlane) GetConditions() clusterv1.Conditions {
 	return r.Status.Conditions
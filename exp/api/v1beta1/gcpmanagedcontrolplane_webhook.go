@@ -18,15 +18,19 @@ package v1beta1
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/cluster-api-provider-gcp/util/hash"
+	"sigs.k8s.io/cluster-api-provider-gcp/util/location"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -38,6 +42,9 @@ const (
 	resourcePrefix       = "capg-"
 )
 
+// kmsKeyNameRegexp matches the resource name of a Cloud KMS key and captures its location.
+var kmsKeyNameRegexp = regexp.MustCompile(`^projects/[^/]+/locations/([^/]+)/keyRings/[^/]+/cryptoKeys/[^/]+$`)
+
 // log is for logging in this package.
 var gcpmanagedcontrolplanelog = logf.Log.WithName("gcpmanagedcontrolplane-resource")
 
@@ -95,9 +102,37 @@ func (r *GCPManagedControlPlane) ValidateCreate() (admission.Warnings, error) {
 
 	if r.Spec.EnableAutopilot && r.Spec.MonitoringService != nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "MonitoringService"),
-			r.Spec.LoggingService, "can't be set when autopilot is enabled"))
+			r.Spec.MonitoringService, "can't be set when autopilot is enabled"))
+	}
+
+	if r.Spec.LoggingService != nil {
+		err := r.Spec.LoggingService.Validate()
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "LoggingService"),
+				r.Spec.LoggingService, err.Error()))
+		}
+	}
+
+	if r.Spec.MonitoringService != nil {
+		err := r.Spec.MonitoringService.Validate()
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "MonitoringService"),
+				r.Spec.MonitoringService, err.Error()))
+		}
 	}
 
+	allErrs = append(allErrs, r.validateIdentityConfig()...)
+	allErrs = append(allErrs, r.validateMaintenancePolicy()...)
+	allErrs = append(allErrs, r.validateAddonsConfig()...)
+	allErrs = append(allErrs, r.validateClusterNetwork()...)
+	allErrs = append(allErrs, r.validateSecurityPosture()...)
+	allErrs = append(allErrs, r.validateObservability()...)
+	allErrs = append(allErrs, r.validateClusterAutoscaling()...)
+	allErrs = append(allErrs, r.validateKubeconfig()...)
+	allErrs = append(allErrs, r.validateEndpointType()...)
+	allErrs = append(allErrs, r.validateAutopilot()...)
+	allErrs = append(allErrs, r.validateFleet()...)
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -105,6 +140,346 @@ func (r *GCPManagedControlPlane) ValidateCreate() (admission.Warnings, error) {
 	return nil, apierrors.NewInvalid(GroupVersion.WithKind("GCPManagedControlPlane").GroupKind(), r.Name, allErrs)
 }
 
+// validateIdentityConfig validates the Workload Identity and Google Groups for RBAC configuration.
+func (r *GCPManagedControlPlane) validateIdentityConfig() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.WorkloadIdentityConfig != nil {
+		if err := r.Spec.WorkloadIdentityConfig.Validate(); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "WorkloadIdentityConfig", "WorkloadPool"),
+				r.Spec.WorkloadIdentityConfig.WorkloadPool, err.Error()))
+		}
+	}
+
+	if r.Spec.AuthenticatorGroupConfig != nil {
+		if err := r.Spec.AuthenticatorGroupConfig.Validate(); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "AuthenticatorGroupConfig", "SecurityGroups"),
+				r.Spec.AuthenticatorGroupConfig.SecurityGroups, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateMaintenancePolicy validates the maintenance windows and exclusions.
+func (r *GCPManagedControlPlane) validateMaintenancePolicy() field.ErrorList {
+	var allErrs field.ErrorList
+
+	policy := r.Spec.MaintenancePolicy
+	if policy == nil {
+		return allErrs
+	}
+	policyPath := field.NewPath("spec", "MaintenancePolicy")
+
+	if policy.DailyMaintenanceWindow != nil && policy.RecurringWindow != nil {
+		allErrs = append(allErrs, field.Forbidden(policyPath.Child("RecurringWindow"),
+			"can't be set together with DailyMaintenanceWindow"))
+	}
+
+	if policy.RecurringWindow != nil {
+		windowPath := policyPath.Child("RecurringWindow")
+		if !policy.RecurringWindow.EndTime.After(policy.RecurringWindow.StartTime.Time) {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("EndTime"),
+				policy.RecurringWindow.EndTime, "must be after StartTime"))
+		}
+		if !strings.HasPrefix(policy.RecurringWindow.Recurrence, "FREQ=") {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("Recurrence"),
+				policy.RecurringWindow.Recurrence, "must be an RRULE starting with FREQ="))
+		}
+	}
+
+	names := make(map[string]bool, len(policy.MaintenanceExclusions))
+	for i, exclusion := range policy.MaintenanceExclusions {
+		exclusionPath := policyPath.Child("MaintenanceExclusions").Index(i)
+		if names[exclusion.Name] {
+			allErrs = append(allErrs, field.Duplicate(exclusionPath.Child("Name"), exclusion.Name))
+		}
+		names[exclusion.Name] = true
+		if !exclusion.EndTime.After(exclusion.StartTime.Time) {
+			allErrs = append(allErrs, field.Invalid(exclusionPath.Child("EndTime"),
+				exclusion.EndTime, "must be after StartTime"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAddonsConfig validates the add-ons against the cluster mode and their dependencies.
+func (r *GCPManagedControlPlane) validateAddonsConfig() field.ErrorList {
+	var allErrs field.ErrorList
+
+	addons := r.Spec.AddonsConfig
+	if addons == nil {
+		return allErrs
+	}
+	addonsPath := field.NewPath("spec", "AddonsConfig")
+
+	// Autopilot clusters always run Dataplane V2 and NodeLocal DNSCache.
+	if r.Spec.EnableAutopilot && addons.NetworkPolicy != nil {
+		allErrs = append(allErrs, field.Invalid(addonsPath.Child("NetworkPolicy"),
+			*addons.NetworkPolicy, "can't be set when autopilot is enabled"))
+	}
+	if r.Spec.EnableAutopilot && addons.NodeLocalDNS != nil {
+		allErrs = append(allErrs, field.Invalid(addonsPath.Child("NodeLocalDNS"),
+			*addons.NodeLocalDNS, "can't be set when autopilot is enabled"))
+	}
+
+	if addons.ConfigConnector != nil && *addons.ConfigConnector && r.Spec.WorkloadIdentityConfig == nil && !r.Spec.EnableAutopilot {
+		allErrs = append(allErrs, field.Invalid(addonsPath.Child("ConfigConnector"),
+			*addons.ConfigConnector, "requires WorkloadIdentityConfig to be set"))
+	}
+
+	return allErrs
+}
+
+// validateClusterNetwork validates the IP ranges, dataplane and DNS settings of the cluster network.
+func (r *GCPManagedControlPlane) validateClusterNetwork() field.ErrorList {
+	var allErrs field.ErrorList
+
+	cn := r.Spec.ClusterNetwork
+	if cn == nil {
+		return allErrs
+	}
+	networkPath := field.NewPath("spec", "ClusterNetwork")
+
+	if cn.Pod != nil {
+		if cn.Pod.CidrBlock != "" && cn.Pod.SecondaryRangeName != "" {
+			allErrs = append(allErrs, field.Forbidden(networkPath.Child("Pod", "SecondaryRangeName"),
+				"can't be set together with CidrBlock"))
+		}
+		if cn.Pod.SecondaryRangeName != "" && !cn.UseIPAliases {
+			allErrs = append(allErrs, field.Invalid(networkPath.Child("Pod", "SecondaryRangeName"),
+				cn.Pod.SecondaryRangeName, "requires UseIPAliases"))
+		}
+	}
+
+	if cn.Service != nil {
+		if cn.Service.CidrBlock != "" && cn.Service.SecondaryRangeName != "" {
+			allErrs = append(allErrs, field.Forbidden(networkPath.Child("Service", "SecondaryRangeName"),
+				"can't be set together with CidrBlock"))
+		}
+		if cn.Service.SecondaryRangeName != "" && !cn.UseIPAliases {
+			allErrs = append(allErrs, field.Invalid(networkPath.Child("Service", "SecondaryRangeName"),
+				cn.Service.SecondaryRangeName, "requires UseIPAliases"))
+		}
+	}
+
+	if cn.DatapathProvider != nil {
+		switch {
+		case r.Spec.EnableAutopilot && *cn.DatapathProvider != AdvancedDatapath:
+			allErrs = append(allErrs, field.Invalid(networkPath.Child("DatapathProvider"),
+				*cn.DatapathProvider, "autopilot clusters always use AdvancedDatapath"))
+		case *cn.DatapathProvider == AdvancedDatapath && !cn.UseIPAliases && !r.Spec.EnableAutopilot:
+			allErrs = append(allErrs, field.Invalid(networkPath.Child("DatapathProvider"),
+				*cn.DatapathProvider, "requires UseIPAliases"))
+		case *cn.DatapathProvider == AdvancedDatapath && r.Spec.AddonsConfig != nil && ptr.Deref(r.Spec.AddonsConfig.NetworkPolicy, false):
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "AddonsConfig", "NetworkPolicy"),
+				*r.Spec.AddonsConfig.NetworkPolicy, "can't be enabled with AdvancedDatapath which enforces network policies itself"))
+		}
+	}
+
+	if cn.DNSConfig != nil {
+		dnsPath := networkPath.Child("DNSConfig")
+		if cn.DNSConfig.Scope != nil && cn.DNSConfig.Provider != CloudDNS {
+			allErrs = append(allErrs, field.Invalid(dnsPath.Child("Scope"),
+				*cn.DNSConfig.Scope, "requires the CloudDNS provider"))
+		}
+		if cn.DNSConfig.Domain != "" && ptr.Deref(cn.DNSConfig.Scope, "") != ClusterDNSScopeVPC {
+			allErrs = append(allErrs, field.Invalid(dnsPath.Child("Domain"),
+				cn.DNSConfig.Domain, "requires VPCScope"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSecurityPosture validates the shielded nodes and database encryption settings.
+func (r *GCPManagedControlPlane) validateSecurityPosture() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.EnableAutopilot && r.Spec.EnableShieldedNodes != nil && !*r.Spec.EnableShieldedNodes {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "EnableShieldedNodes"),
+			*r.Spec.EnableShieldedNodes, "autopilot clusters always use shielded nodes"))
+	}
+
+	if r.Spec.DatabaseEncryption != nil && r.Spec.DatabaseEncryption.KeyName != "" {
+		keyNamePath := field.NewPath("spec", "DatabaseEncryption", "KeyName")
+		match := kmsKeyNameRegexp.FindStringSubmatch(r.Spec.DatabaseEncryption.KeyName)
+		if match == nil {
+			allErrs = append(allErrs, field.Invalid(keyNamePath, r.Spec.DatabaseEncryption.KeyName,
+				"expect projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>"))
+		} else if loc, err := location.Parse(r.Spec.Location); err == nil && match[1] != loc.Region {
+			allErrs = append(allErrs, field.Invalid(keyNamePath, r.Spec.DatabaseEncryption.KeyName,
+				fmt.Sprintf("key must be in the region of the cluster (%s)", loc.Region)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateObservability validates the Cloud Logging and Cloud Monitoring configuration.
+func (r *GCPManagedControlPlane) validateObservability() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.LoggingConfig != nil {
+		loggingPath := field.NewPath("spec", "LoggingConfig")
+		if r.Spec.LoggingService != nil && *r.Spec.LoggingService == "none" {
+			allErrs = append(allErrs, field.Forbidden(loggingPath, "can't be set when LoggingService is none"))
+		}
+		if !slices.Contains(r.Spec.LoggingConfig.EnableComponents, LoggingComponentSystemComponents) {
+			allErrs = append(allErrs, field.Invalid(loggingPath.Child("EnableComponents"),
+				r.Spec.LoggingConfig.EnableComponents, fmt.Sprintf("must include %s", LoggingComponentSystemComponents)))
+		}
+	}
+
+	if r.Spec.MonitoringConfig != nil {
+		monitoringPath := field.NewPath("spec", "MonitoringConfig")
+		if r.Spec.MonitoringService != nil && *r.Spec.MonitoringService == "none" {
+			allErrs = append(allErrs, field.Forbidden(monitoringPath, "can't be set when MonitoringService is none"))
+		}
+		components := r.Spec.MonitoringConfig.EnableComponents
+		if len(components) > 0 && !slices.Contains(components, MonitoringComponentSystemComponents) {
+			allErrs = append(allErrs, field.Invalid(monitoringPath.Child("EnableComponents"),
+				components, fmt.Sprintf("must include %s", MonitoringComponentSystemComponents)))
+		}
+		if r.Spec.MonitoringConfig.AdvancedDatapathObservability != nil && !r.Spec.EnableAutopilot &&
+			(r.Spec.ClusterNetwork == nil || ptr.Deref(r.Spec.ClusterNetwork.DatapathProvider, "") != AdvancedDatapath) {
+			allErrs = append(allErrs, field.Invalid(monitoringPath.Child("AdvancedDatapathObservability"),
+				r.Spec.MonitoringConfig.AdvancedDatapathObservability, "requires the AdvancedDatapath datapath provider"))
+		}
+	}
+
+	if r.Spec.ResourceUsageExport != nil && r.Spec.EnableAutopilot {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "ResourceUsageExport"), "is not supported on autopilot clusters"))
+	}
+
+	return allErrs
+}
+
+// validateClusterAutoscaling validates the cluster autoscaler and node auto-provisioning configuration.
+func (r *GCPManagedControlPlane) validateClusterAutoscaling() field.ErrorList {
+	var allErrs field.ErrorList
+	autoscaling := r.Spec.ClusterAutoscaling
+	if autoscaling == nil {
+		return allErrs
+	}
+
+	autoscalingPath := field.NewPath("spec", "ClusterAutoscaling")
+	if r.Spec.EnableAutopilot {
+		allErrs = append(allErrs, field.Forbidden(autoscalingPath, "can't be set when autopilot is enabled"))
+	}
+
+	for i, limit := range autoscaling.ResourceLimits {
+		if limit.Minimum > limit.Maximum {
+			allErrs = append(allErrs, field.Invalid(autoscalingPath.Child("ResourceLimits").Index(i).Child("Minimum"),
+				limit.Minimum, "must be less than or equal to maximum"))
+		}
+	}
+
+	if autoscaling.EnableNodeAutoprovisioning {
+		for _, resourceType := range []string{"cpu", "memory"} {
+			if !slices.ContainsFunc(autoscaling.ResourceLimits, func(limit ResourceLimit) bool { return limit.ResourceType == resourceType }) {
+				allErrs = append(allErrs, field.Required(autoscalingPath.Child("ResourceLimits"),
+					fmt.Sprintf("a %s limit is required when node auto-provisioning is enabled", resourceType)))
+			}
+		}
+	} else {
+		if len(autoscaling.AutoprovisioningLocations) > 0 {
+			allErrs = append(allErrs, field.Forbidden(autoscalingPath.Child("AutoprovisioningLocations"),
+				"can only be set when node auto-provisioning is enabled"))
+		}
+		if autoscaling.AutoprovisioningNodePoolDefaults != nil {
+			allErrs = append(allErrs, field.Forbidden(autoscalingPath.Child("AutoprovisioningNodePoolDefaults"),
+				"can only be set when node auto-provisioning is enabled"))
+		}
+	}
+
+	if defaults := autoscaling.AutoprovisioningNodePoolDefaults; defaults != nil && defaults.BootDiskKMSKey != nil &&
+		!kmsKeyNameRegexp.MatchString(*defaults.BootDiskKMSKey) {
+		allErrs = append(allErrs, field.Invalid(autoscalingPath.Child("AutoprovisioningNodePoolDefaults", "BootDiskKMSKey"),
+			*defaults.BootDiskKMSKey, "must be in the form projects/[KEY_PROJECT_ID]/locations/[LOCATION]/keyRings/[RING_NAME]/cryptoKeys/[KEY_NAME]"))
+	}
+
+	return allErrs
+}
+
+// validateKubeconfig validates the kubeconfig configuration.
+func (r *GCPManagedControlPlane) validateKubeconfig() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.Kubeconfig == nil || r.Spec.Kubeconfig.ServiceAccountTokenTTL == nil {
+		return allErrs
+	}
+
+	ttlPath := field.NewPath("spec", "Kubeconfig", "ServiceAccountTokenTTL")
+	if r.Spec.Kubeconfig.CAPIAuthMode != KubeconfigServiceAccountToken {
+		allErrs = append(allErrs, field.Forbidden(ttlPath, "can only be set when capiAuthMode is ServiceAccountToken"))
+	}
+	if ttl := r.Spec.Kubeconfig.ServiceAccountTokenTTL.Duration; ttl < MinServiceAccountTokenTTL {
+		allErrs = append(allErrs, field.Invalid(ttlPath, ttl.String(), fmt.Sprintf("must be at least %s", MinServiceAccountTokenTTL)))
+	}
+
+	return allErrs
+}
+
+// validateAutopilot validates the autopilot configuration.
+func (r *GCPManagedControlPlane) validateAutopilot() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.Autopilot == nil {
+		return allErrs
+	}
+
+	if !r.Spec.EnableAutopilot {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "Autopilot"), "can only be set when enableAutopilot is true"))
+	}
+
+	return allErrs
+}
+
+// validateFleet validates the fleet feature configuration.
+func (r *GCPManagedControlPlane) validateFleet() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.Fleet == nil || r.Spec.Fleet.ConfigSync == nil {
+		return allErrs
+	}
+
+	git := r.Spec.Fleet.ConfigSync.Git
+	gitPath := field.NewPath("spec", "Fleet", "ConfigSync", "Git")
+	if git.SecretType == "gcpserviceaccount" && git.GCPServiceAccountEmail == "" {
+		allErrs = append(allErrs, field.Required(gitPath.Child("GCPServiceAccountEmail"),
+			"must be set when secretType is gcpserviceaccount"))
+	}
+	if git.SecretType != "gcpserviceaccount" && git.GCPServiceAccountEmail != "" {
+		allErrs = append(allErrs, field.Forbidden(gitPath.Child("GCPServiceAccountEmail"),
+			"can only be set when secretType is gcpserviceaccount"))
+	}
+
+	return allErrs
+}
+
+// validateEndpointType validates the selected control plane endpoint is available.
+func (r *GCPManagedControlPlane) validateEndpointType() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.EndpointType == nil {
+		return allErrs
+	}
+
+	endpointTypePath := field.NewPath("spec", "EndpointType")
+	switch *r.Spec.EndpointType {
+	case ControlPlaneEndpointPublicIP:
+		if cn := r.Spec.ClusterNetwork; cn != nil && cn.PrivateCluster != nil && cn.PrivateCluster.EnablePrivateEndpoint {
+			allErrs = append(allErrs, field.Invalid(endpointTypePath, *r.Spec.EndpointType,
+				"the public IP endpoint is disabled when enablePrivateEndpoint is set"))
+		}
+	case ControlPlaneEndpointDNS:
+		if r.Spec.DNSEndpoint == nil || !r.Spec.DNSEndpoint.AllowExternalTraffic {
+			allErrs = append(allErrs, field.Invalid(endpointTypePath, *r.Spec.EndpointType,
+				"the DNS endpoint requires dnsEndpoint.allowExternalTraffic"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
 func (r *GCPManagedControlPlane) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
 	gcpmanagedcontrolplanelog.Info("validate update", "name", r.Name)
@@ -132,6 +507,15 @@ func (r *GCPManagedControlPlane) ValidateUpdate(oldRaw runtime.Object) (admissio
 		)
 	}
 
+	oldAutopilot := ptr.Deref(old.Spec.Autopilot, AutopilotConfig{})
+	newAutopilot := ptr.Deref(r.Spec.Autopilot, AutopilotConfig{})
+	if oldAutopilot.NodeServiceAccount != newAutopilot.NodeServiceAccount {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "Autopilot", "NodeServiceAccount"),
+				newAutopilot.NodeServiceAccount, "field is immutable"),
+		)
+	}
+
 	if !cmp.Equal(r.Spec.EnableAutopilot, old.Spec.EnableAutopilot) {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "EnableAutopilot"),
@@ -139,6 +523,36 @@ func (r *GCPManagedControlPlane) ValidateUpdate(oldRaw runtime.Object) (admissio
 		)
 	}
 
+	oldCN := ptr.Deref(old.Spec.ClusterNetwork, ClusterNetwork{})
+	newCN := ptr.Deref(r.Spec.ClusterNetwork, ClusterNetwork{})
+	if !cmp.Equal(newCN.UseIPAliases, oldCN.UseIPAliases) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "ClusterNetwork", "UseIPAliases"),
+				newCN.UseIPAliases, "field is immutable"),
+		)
+	}
+
+	if !cmp.Equal(newCN.Pod, oldCN.Pod) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "ClusterNetwork", "Pod"),
+				newCN.Pod, "field is immutable"),
+		)
+	}
+
+	if !cmp.Equal(newCN.Service, oldCN.Service) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "ClusterNetwork", "Service"),
+				newCN.Service, "field is immutable"),
+		)
+	}
+
+	if !cmp.Equal(newCN.DatapathProvider, oldCN.DatapathProvider) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "ClusterNetwork", "DatapathProvider"),
+				newCN.DatapathProvider, "field is immutable"),
+		)
+	}
+
 	if old.Spec.EnableAutopilot && r.Spec.LoggingService != nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "LoggingService"),
 			r.Spec.LoggingService, "can't be set when autopilot is enabled"))
@@ -146,7 +560,7 @@ func (r *GCPManagedControlPlane) ValidateUpdate(oldRaw runtime.Object) (admissio
 
 	if old.Spec.EnableAutopilot && r.Spec.MonitoringService != nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "MonitoringService"),
-			r.Spec.LoggingService, "can't be set when autopilot is enabled"))
+			r.Spec.MonitoringService, "can't be set when autopilot is enabled"))
 	}
 
 	if r.Spec.LoggingService != nil {
@@ -165,6 +579,18 @@ func (r *GCPManagedControlPlane) ValidateUpdate(oldRaw runtime.Object) (admissio
 		}
 	}
 
+	allErrs = append(allErrs, r.validateIdentityConfig()...)
+	allErrs = append(allErrs, r.validateMaintenancePolicy()...)
+	allErrs = append(allErrs, r.validateAddonsConfig()...)
+	allErrs = append(allErrs, r.validateClusterNetwork()...)
+	allErrs = append(allErrs, r.validateSecurityPosture()...)
+	allErrs = append(allErrs, r.validateObservability()...)
+	allErrs = append(allErrs, r.validateClusterAutoscaling()...)
+	allErrs = append(allErrs, r.validateKubeconfig()...)
+	allErrs = append(allErrs, r.validateEndpointType()...)
+	allErrs = append(allErrs, r.validateAutopilot()...)
+	allErrs = append(allErrs, r.validateFleet()...)
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
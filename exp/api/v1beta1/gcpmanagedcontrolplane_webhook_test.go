@@ -19,9 +19,11 @@ package v1beta1
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 var (
@@ -147,6 +149,540 @@ func TestGCPManagedControlPlaneValidatingWebhookCreate(t *testing.T) {
 				ReleaseChannel:  &releaseChannel,
 			},
 		},
+		{
+			name:        "valid workload pool and security group",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				WorkloadIdentityConfig: &WorkloadIdentityConfig{
+					WorkloadPool: "my-project.svc.id.goog",
+				},
+				AuthenticatorGroupConfig: &AuthenticatorGroupConfig{
+					SecurityGroups: "gke-security-groups@example.com",
+				},
+			},
+		},
+		{
+			name:        "invalid workload pool should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				WorkloadIdentityConfig: &WorkloadIdentityConfig{
+					WorkloadPool: "my-project",
+				},
+			},
+		},
+		{
+			name:        "invalid security group should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				AuthenticatorGroupConfig: &AuthenticatorGroupConfig{
+					SecurityGroups: "admins@example.com",
+				},
+			},
+		},
+		{
+			name:        "config connector without workload identity should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				AddonsConfig: &AddonsConfig{
+					ConfigConnector: ptr.To(true),
+				},
+			},
+		},
+		{
+			name:        "network policy add-on with autopilot should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:     "",
+				EnableAutopilot: true,
+				ReleaseChannel:  &releaseChannel,
+				AddonsConfig: &AddonsConfig{
+					NetworkPolicy: ptr.To(true),
+				},
+			},
+		},
+		{
+			name:        "valid add-ons",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				WorkloadIdentityConfig: &WorkloadIdentityConfig{
+					WorkloadPool: "my-project.svc.id.goog",
+				},
+				AddonsConfig: &AddonsConfig{
+					HTTPLoadBalancing: ptr.To(false),
+					GcsFuseCsiDriver:  ptr.To(true),
+					ConfigConnector:   ptr.To(true),
+				},
+			},
+		},
+		{
+			name:        "secondary range names with IP aliases",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterNetwork: &ClusterNetwork{
+					UseIPAliases:     true,
+					Pod:              &ClusterNetworkPod{SecondaryRangeName: "pods"},
+					Service:          &ClusterNetworkService{SecondaryRangeName: "services"},
+					DatapathProvider: ptr.To(AdvancedDatapath),
+					DNSConfig: &ClusterDNSConfig{
+						Provider: CloudDNS,
+						Scope:    ptr.To(ClusterDNSScopeVPC),
+						Domain:   "cluster1.example.com",
+					},
+				},
+			},
+		},
+		{
+			name:        "secondary range name without IP aliases should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterNetwork: &ClusterNetwork{
+					Pod: &ClusterNetworkPod{SecondaryRangeName: "pods"},
+				},
+			},
+		},
+		{
+			name:        "secondary range name and CIDR block should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterNetwork: &ClusterNetwork{
+					UseIPAliases: true,
+					Service:      &ClusterNetworkService{CidrBlock: "10.0.0.0/20", SecondaryRangeName: "services"},
+				},
+			},
+		},
+		{
+			name:        "advanced datapath with network policy add-on should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterNetwork: &ClusterNetwork{
+					UseIPAliases:     true,
+					DatapathProvider: ptr.To(AdvancedDatapath),
+				},
+				AddonsConfig: &AddonsConfig{
+					NetworkPolicy: ptr.To(true),
+				},
+			},
+		},
+		{
+			name:        "DNS scope without Cloud DNS should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterNetwork: &ClusterNetwork{
+					DNSConfig: &ClusterDNSConfig{
+						Provider: KubeDNS,
+						Scope:    ptr.To(ClusterDNSScopeCluster),
+					},
+				},
+			},
+		},
+		{
+			name:        "valid security settings",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Location:    "us-central1-a",
+				BinaryAuthorization: &BinaryAuthorization{
+					EvaluationMode: BinaryAuthorizationProjectSingletonPolicyEnforce,
+				},
+				EnableShieldedNodes: ptr.To(true),
+				SecurityPosture: &SecurityPosture{
+					Mode:              ptr.To(SecurityPostureBasic),
+					VulnerabilityMode: ptr.To(SecurityPostureEnterprise),
+				},
+				DatabaseEncryption: &DatabaseEncryption{
+					KeyName: "projects/my-project/locations/us-central1/keyRings/gke/cryptoKeys/etcd",
+				},
+			},
+		},
+		{
+			name:        "database encryption key in another region should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Location:    "us-central1",
+				DatabaseEncryption: &DatabaseEncryption{
+					KeyName: "projects/my-project/locations/europe-west1/keyRings/gke/cryptoKeys/etcd",
+				},
+			},
+		},
+		{
+			name:        "invalid database encryption key should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				DatabaseEncryption: &DatabaseEncryption{
+					KeyName: "etcd",
+				},
+			},
+		},
+		{
+			name:        "shielded nodes disabled with autopilot should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:         "",
+				EnableAutopilot:     true,
+				ReleaseChannel:      &releaseChannel,
+				EnableShieldedNodes: ptr.To(false),
+			},
+		},
+		{
+			name:        "valid maintenance policy",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				MaintenancePolicy: &MaintenancePolicy{
+					RecurringWindow: &RecurringMaintenanceWindow{
+						StartTime:  metav1.NewTime(time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC)),
+						EndTime:    metav1.NewTime(time.Date(2024, 1, 6, 8, 0, 0, 0, time.UTC)),
+						Recurrence: "FREQ=WEEKLY;BYDAY=SA,SU",
+					},
+					MaintenanceExclusions: []MaintenanceExclusion{
+						{
+							Name:      "freeze",
+							StartTime: metav1.NewTime(time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)),
+							EndTime:   metav1.NewTime(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "daily and recurring maintenance windows should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				MaintenancePolicy: &MaintenancePolicy{
+					DailyMaintenanceWindow: &DailyMaintenanceWindow{StartTime: "03:00"},
+					RecurringWindow: &RecurringMaintenanceWindow{
+						StartTime:  metav1.NewTime(time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC)),
+						EndTime:    metav1.NewTime(time.Date(2024, 1, 6, 8, 0, 0, 0, time.UTC)),
+						Recurrence: "FREQ=WEEKLY;BYDAY=SA,SU",
+					},
+				},
+			},
+		},
+		{
+			name:        "maintenance exclusion ending before it starts should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				MaintenancePolicy: &MaintenancePolicy{
+					MaintenanceExclusions: []MaintenanceExclusion{
+						{
+							Name:      "freeze",
+							StartTime: metav1.NewTime(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)),
+							EndTime:   metav1.NewTime(time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC)),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "valid cluster autoscaling",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterAutoscaling: &ClusterAutoscaling{
+					AutoscalingProfile:         ptr.To(AutoscalingProfileOptimizeUtilization),
+					EnableNodeAutoprovisioning: true,
+					ResourceLimits: []ResourceLimit{
+						{ResourceType: "cpu", Maximum: 100},
+						{ResourceType: "memory", Maximum: 400},
+						{ResourceType: "nvidia-tesla-t4", Maximum: 4},
+					},
+					AutoprovisioningNodePoolDefaults: &AutoprovisioningNodePoolDefaults{
+						ServiceAccount: ptr.To("nodes@my-project.iam.gserviceaccount.com"),
+						ImageType:      ptr.To("COS_CONTAINERD"),
+					},
+				},
+			},
+		},
+		{
+			name:        "node auto-provisioning without memory limit should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterAutoscaling: &ClusterAutoscaling{
+					EnableNodeAutoprovisioning: true,
+					ResourceLimits: []ResourceLimit{
+						{ResourceType: "cpu", Maximum: 100},
+					},
+				},
+			},
+		},
+		{
+			name:        "resource limit minimum above maximum should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterAutoscaling: &ClusterAutoscaling{
+					ResourceLimits: []ResourceLimit{
+						{ResourceType: "nvidia-tesla-t4", Minimum: 8, Maximum: 4},
+					},
+				},
+			},
+		},
+		{
+			name:        "auto-provisioning defaults without node auto-provisioning should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterAutoscaling: &ClusterAutoscaling{
+					AutoprovisioningNodePoolDefaults: &AutoprovisioningNodePoolDefaults{
+						ImageType: ptr.To("COS_CONTAINERD"),
+					},
+				},
+			},
+		},
+		{
+			name:        "cluster autoscaling with autopilot should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:     "",
+				EnableAutopilot: true,
+				ReleaseChannel:  &releaseChannel,
+				ClusterAutoscaling: &ClusterAutoscaling{
+					AutoscalingProfile: ptr.To(AutoscalingProfileOptimizeUtilization),
+				},
+			},
+		},
+		{
+			name:        "valid logging and monitoring configuration",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterNetwork: &ClusterNetwork{
+					UseIPAliases:     true,
+					DatapathProvider: ptr.To(AdvancedDatapath),
+				},
+				LoggingConfig: &LoggingConfig{
+					EnableComponents: []LoggingComponent{LoggingComponentSystemComponents, LoggingComponentAPIServer},
+				},
+				MonitoringConfig: &MonitoringConfig{
+					EnableComponents:        []MonitoringComponent{MonitoringComponentSystemComponents, MonitoringComponentKubelet},
+					EnableManagedPrometheus: ptr.To(true),
+					AdvancedDatapathObservability: &AdvancedDatapathObservability{
+						EnableMetrics: true,
+					},
+				},
+			},
+		},
+		{
+			name:        "invalid logging service should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:    "",
+				LoggingService: ptr.To(LoggingService("logging.example.com")),
+			},
+		},
+		{
+			name:        "logging components without system components should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				LoggingConfig: &LoggingConfig{
+					EnableComponents: []LoggingComponent{LoggingComponentWorkloads},
+				},
+			},
+		},
+		{
+			name:        "monitoring configuration with monitoring disabled should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:       "",
+				MonitoringService: ptr.To(MonitoringService("none")),
+				MonitoringConfig: &MonitoringConfig{
+					EnableManagedPrometheus: ptr.To(true),
+				},
+			},
+		},
+		{
+			name:        "advanced datapath observability without advanced datapath should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				MonitoringConfig: &MonitoringConfig{
+					AdvancedDatapathObservability: &AdvancedDatapathObservability{
+						EnableMetrics: true,
+					},
+				},
+			},
+		},
+		{
+			name:        "service account token kubeconfig with a token TTL should not cause an error",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Kubeconfig: &KubeconfigConfig{
+					CAPIAuthMode:           KubeconfigServiceAccountToken,
+					UserAuthMode:           KubeconfigExecPlugin,
+					ServiceAccountTokenTTL: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+		},
+		{
+			name:        "token TTL without service account token kubeconfig should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Kubeconfig: &KubeconfigConfig{
+					CAPIAuthMode:           KubeconfigTokenRefresh,
+					ServiceAccountTokenTTL: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+		},
+		{
+			name:        "DNS endpoint with external traffic allowed should not cause an error",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:  "",
+				DNSEndpoint:  &DNSEndpointConfig{AllowExternalTraffic: true},
+				EndpointType: ptr.To(ControlPlaneEndpointDNS),
+			},
+		},
+		{
+			name:        "DNS endpoint without external traffic allowed should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:  "",
+				EndpointType: ptr.To(ControlPlaneEndpointDNS),
+			},
+		},
+		{
+			name:        "public IP endpoint with the private endpoint enabled should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ClusterNetwork: &ClusterNetwork{
+					PrivateCluster: &PrivateCluster{EnablePrivateEndpoint: true},
+				},
+				EndpointType: ptr.To(ControlPlaneEndpointPublicIP),
+			},
+		},
+		{
+			name:        "autopilot configuration on an autopilot cluster should not cause an error",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:     "",
+				EnableAutopilot: true,
+				ReleaseChannel:  &releaseChannel,
+				Autopilot: &AutopilotConfig{
+					WorkloadPolicies:    &AutopilotWorkloadPolicies{AllowNetAdmin: true},
+					NodeServiceAccount:  "nodes@my-project.iam.gserviceaccount.com",
+					ResourceManagerTags: map[string]string{"tagKeys/123": "tagValues/456"},
+				},
+				AddonsConfig: &AddonsConfig{
+					GkeBackupAgent: ptr.To(true),
+					SecretManager:  ptr.To(true),
+				},
+			},
+		},
+		{
+			name:        "autopilot configuration on a standard cluster should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Autopilot: &AutopilotConfig{
+					WorkloadPolicies: &AutopilotWorkloadPolicies{AllowNetAdmin: true},
+				},
+			},
+		},
+		{
+			name:        "token TTL below the minimum should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Kubeconfig: &KubeconfigConfig{
+					CAPIAuthMode:           KubeconfigServiceAccountToken,
+					ServiceAccountTokenTTL: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+		},
+		{
+			name:        "fleet with config sync and policy controller should not cause an error",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Fleet: &FleetConfig{
+					Project: "fleet-host",
+					ConfigSync: &ConfigSyncConfig{
+						Git: ConfigSyncGitConfig{
+							SyncRepo:               "https://github.com/example/config",
+							SecretType:             "gcpserviceaccount",
+							GCPServiceAccountEmail: "config-sync@fleet-host.iam.gserviceaccount.com",
+						},
+					},
+					PolicyController: &PolicyControllerConfig{
+						AuditIntervalSeconds: ptr.To[int64](60),
+					},
+				},
+			},
+		},
+		{
+			name:        "resource usage export on an autopilot cluster should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName:     "",
+				EnableAutopilot: true,
+				ReleaseChannel:  &releaseChannel,
+				ResourceUsageExport: &ResourceUsageExportConfig{
+					BigQueryDatasetID: "gke_usage",
+				},
+			},
+		},
+		{
+			name:        "resource usage export and cost allocation on a standard cluster should not cause an error",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				ResourceUsageExport: &ResourceUsageExportConfig{
+					BigQueryDatasetID:         "gke_usage",
+					EnableConsumptionMetering: true,
+				},
+				EnableCostAllocation: ptr.To(true),
+			},
+		},
+		{
+			name:        "config sync with gcpserviceaccount secret type and no email should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Fleet: &FleetConfig{
+					ConfigSync: &ConfigSyncConfig{
+						Git: ConfigSyncGitConfig{
+							SyncRepo:   "https://github.com/example/config",
+							SecretType: "gcpserviceaccount",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "config sync with a service account email and ssh secret type should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "",
+				Fleet: &FleetConfig{
+					ConfigSync: &ConfigSyncConfig{
+						Git: ConfigSyncGitConfig{
+							SyncRepo:               "git@github.com:example/config.git",
+							SecretType:             "ssh",
+							GCPServiceAccountEmail: "config-sync@fleet-host.iam.gserviceaccount.com",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -218,6 +754,65 @@ func TestGCPManagedControlPlaneValidatingWebhookUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "request to change datapath provider should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				ClusterNetwork: &ClusterNetwork{
+					PrivateCluster: &PrivateCluster{
+						EnablePrivateEndpoint: true,
+					},
+					DatapathProvider: ptr.To(LegacyDatapath),
+				},
+			},
+		},
+		{
+			name:        "request to change pod range should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				ClusterNetwork: &ClusterNetwork{
+					PrivateCluster: &PrivateCluster{
+						EnablePrivateEndpoint: true,
+					},
+					Pod: &ClusterNetworkPod{CidrBlock: "10.4.0.0/14"},
+				},
+			},
+		},
+		{
+			name:        "request to enable intra node visibility should not cause an error",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				ClusterNetwork: &ClusterNetwork{
+					PrivateCluster: &PrivateCluster{
+						EnablePrivateEndpoint: true,
+					},
+					EnableIntraNodeVisibility: true,
+				},
+			},
+		},
+		{
+			name:        "request to enable workload identity should not cause an error",
+			expectError: false,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				WorkloadIdentityConfig: &WorkloadIdentityConfig{
+					WorkloadPool: "my-project.svc.id.goog",
+				},
+			},
+		},
+		{
+			name:        "request to set an invalid workload pool should cause an error",
+			expectError: true,
+			spec: GCPManagedControlPlaneSpec{
+				ClusterName: "default_cluster1",
+				WorkloadIdentityConfig: &WorkloadIdentityConfig{
+					WorkloadPool: "my-project.iam.gserviceaccount.com",
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -61,6 +61,12 @@ type GCPManagedMachinePoolSpec struct {
 	// LocalSsdCount is the number of local SSD disks to be attached to the node.
 	// +optional
 	LocalSsdCount *int32 `json:"localSsdCount,omitempty"`
+	// ReplicasPerZone is the number of nodes in each zone of the node pool. When set, the replicas of the MachinePool
+	// must be ReplicasPerZone times the number of zones, otherwise they must be a multiple of the number of zones in
+	// regional clusters.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	ReplicasPerZone *int32 `json:"replicasPerZone,omitempty"`
 	// Scaling specifies scaling for the node pool
 	// +optional
 	Scaling *NodePoolAutoScaling `json:"scaling,omitempty"`
@@ -111,6 +117,54 @@ type GCPManagedMachinePoolSpec struct {
 	// LinuxNodeConfig specifies the settings for Linux agent nodes.
 	// +optional
 	LinuxNodeConfig *LinuxNodeConfig `json:"linuxNodeConfig,omitempty"`
+	// Spot specifies whether the nodes are created as Spot VMs.
+	// +optional
+	Spot bool `json:"spot,omitempty"`
+	// Preemptible specifies whether the nodes are created as preemptible VMs.
+	// +optional
+	Preemptible bool `json:"preemptible,omitempty"`
+	// Accelerators is the list of hardware accelerators attached to each node.
+	// +optional
+	Accelerators []AcceleratorConfig `json:"accelerators,omitempty"`
+	// ReservationAffinity specifies the Compute Engine reservations the nodes can consume.
+	// +optional
+	ReservationAffinity *ReservationAffinity `json:"reservationAffinity,omitempty"`
+	// BootDiskKMSKey is the Cloud KMS key used to encrypt the boot disk of each node, in the form
+	// projects/[KEY_PROJECT_ID]/locations/[LOCATION]/keyRings/[RING_NAME]/cryptoKeys/[KEY_NAME].
+	// +optional
+	BootDiskKMSKey *string `json:"bootDiskKMSKey,omitempty"`
+	// KubeletConfig specifies the kubelet settings of the nodes. Settings can't be removed once set.
+	// +optional
+	KubeletConfig *KubeletConfig `json:"kubeletConfig,omitempty"`
+	// Metadata is the Compute Engine instance metadata set on each node.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// WorkloadMetadataMode specifies how the metadata server is exposed to the workloads running on the nodes.
+	// GKEMetadata requires Workload Identity to be enabled on the cluster. It can't be removed once set.
+	// +optional
+	WorkloadMetadataMode *WorkloadMetadataMode `json:"workloadMetadataMode,omitempty"`
+	// EnableGvnic specifies whether the nodes use Google Virtual NIC. It can't be removed once set.
+	// +optional
+	EnableGvnic *bool `json:"enableGvnic,omitempty"`
+	// EnableConfidentialNodes specifies whether the nodes are Confidential VMs. It can't be removed once set.
+	// +optional
+	EnableConfidentialNodes *bool `json:"enableConfidentialNodes,omitempty"`
+	// ResourceManagerTags are the resource manager tags bound to the nodes. Keys are in the form tagKeys/{tag_key_id}
+	// and values in the form tagValues/{tag_value_id}.
+	// +optional
+	ResourceManagerTags map[string]string `json:"resourceManagerTags,omitempty"`
+	// UpgradeSettings specifies the strategy used to upgrade the nodes of the node pool.
+	// +optional
+	UpgradeSettings *NodePoolUpgradeSettings `json:"upgradeSettings,omitempty"`
+	// Import adopts an existing node pool named NodePoolName instead of failing when one exists. Unset scaling,
+	// labels, taints and node locations are populated from the live node pool when it is adopted. The replicas of
+	// the MachinePool must match the node pool unless it is autoscaled.
+	// +optional
+	Import bool `json:"import,omitempty"`
+	// AllowUnownedDeletion allows deleting the node pool when it was not created by Cluster API, such as an
+	// imported node pool. Otherwise the node pool is left in place when the GCPManagedMachinePool is deleted.
+	// +optional
+	AllowUnownedDeletion bool `json:"allowUnownedDeletion,omitempty"`
 	// ProviderIDList are the provider IDs of instances in the
 	// managed instance group corresponding to the nodegroup represented by this
 	// machine pool
@@ -169,6 +223,185 @@ type ServiceAccountConfig struct {
 	Scopes []string `json:"scopes,omitempty"`
 }
 
+// AcceleratorConfig specifies a hardware accelerator attached to the nodes.
+type AcceleratorConfig struct {
+	// Type is the accelerator type, for example nvidia-tesla-t4.
+	Type string `json:"type"`
+	// Count is the number of accelerators attached to each node.
+	// +kubebuilder:validation:Minimum:=1
+	Count int64 `json:"count"`
+	// GPUPartitionSize is the size of the partitions to create on a multi-instance GPU, for example 1g.5gb.
+	// +optional
+	GPUPartitionSize *string `json:"gpuPartitionSize,omitempty"`
+	// GPUDriverVersion specifies the NVIDIA driver version GKE installs on the nodes. If unspecified, the
+	// GKE default for the node version applies.
+	// +kubebuilder:validation:Enum=Default;Latest;InstallationDisabled
+	// +optional
+	GPUDriverVersion *GPUDriverVersion `json:"gpuDriverVersion,omitempty"`
+}
+
+// GPUDriverVersion is the NVIDIA driver version installed on GPU nodes.
+type GPUDriverVersion string
+
+const (
+	// GPUDriverVersionDefault installs the default driver version for the node version.
+	GPUDriverVersionDefault GPUDriverVersion = "Default"
+	// GPUDriverVersionLatest installs the latest driver version available for the node version.
+	GPUDriverVersionLatest GPUDriverVersion = "Latest"
+	// GPUDriverInstallationDisabled disables driver installation, drivers must be installed manually.
+	GPUDriverInstallationDisabled GPUDriverVersion = "InstallationDisabled"
+)
+
+// ReservationAffinity specifies the Compute Engine reservations the nodes can consume.
+type ReservationAffinity struct {
+	// ConsumeReservationType specifies which reservations the nodes can take capacity from.
+	// +kubebuilder:validation:Enum=NoReservation;AnyReservation;SpecificReservation
+	ConsumeReservationType ReservationAffinityType `json:"consumeReservationType"`
+	// Key is the label key of the reservation resource. Use compute.googleapis.com/reservation-name to
+	// select a reservation by name. Required for SpecificReservation.
+	// +optional
+	Key string `json:"key,omitempty"`
+	// Values are the label values of the reservation resource. Required for SpecificReservation.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// ReservationAffinityType is the type of reservation consumption.
+type ReservationAffinityType string
+
+const (
+	// NoReservation does not consume any reservation.
+	NoReservation ReservationAffinityType = "NoReservation"
+	// AnyReservation consumes any matching reservation.
+	AnyReservation ReservationAffinityType = "AnyReservation"
+	// SpecificReservation consumes only the reservation selected by key and values.
+	SpecificReservation ReservationAffinityType = "SpecificReservation"
+)
+
+// KubeletConfig specifies the kubelet settings of the nodes.
+type KubeletConfig struct {
+	// CPUManagerPolicy is the CPU management policy of the kubelet.
+	// +kubebuilder:validation:Enum=none;static
+	// +optional
+	CPUManagerPolicy *string `json:"cpuManagerPolicy,omitempty"`
+	// CPUCFSQuota enables CPU CFS quota enforcement for containers that specify CPU limits.
+	// +optional
+	CPUCFSQuota *bool `json:"cpuCFSQuota,omitempty"`
+	// CPUCFSQuotaPeriod is the CPU CFS quota period, between 1ms and 1s, for example 100ms.
+	// +optional
+	CPUCFSQuotaPeriod *string `json:"cpuCFSQuotaPeriod,omitempty"`
+	// PodPidsLimit is the maximum number of process IDs per pod.
+	// +kubebuilder:validation:Minimum:=1024
+	// +kubebuilder:validation:Maximum:=4194304
+	// +optional
+	PodPidsLimit *int64 `json:"podPidsLimit,omitempty"`
+}
+
+// WorkloadMetadataMode specifies how the metadata server is exposed to workloads.
+// +kubebuilder:validation:Enum=GCEMetadata;GKEMetadata
+type WorkloadMetadataMode string
+
+const (
+	// GCEMetadata exposes the Compute Engine metadata server to workloads.
+	GCEMetadata WorkloadMetadataMode = "GCEMetadata"
+	// GKEMetadata runs the GKE metadata server, which exposes Workload Identity credentials to workloads.
+	GKEMetadata WorkloadMetadataMode = "GKEMetadata"
+)
+
+// NodePoolUpgradeStrategy is the strategy used to upgrade the nodes of a node pool.
+type NodePoolUpgradeStrategy string
+
+const (
+	// SurgeUpgradeStrategy upgrades the nodes in place, a few at a time, by creating surge nodes.
+	SurgeUpgradeStrategy NodePoolUpgradeStrategy = "Surge"
+	// BlueGreenUpgradeStrategy creates a new set of nodes and drains the old ones in batches.
+	BlueGreenUpgradeStrategy NodePoolUpgradeStrategy = "BlueGreen"
+)
+
+// NodePoolUpgradeSettings specifies the strategy used to upgrade the nodes of a node pool.
+type NodePoolUpgradeSettings struct {
+	// Strategy is the upgrade strategy of the node pool.
+	// +kubebuilder:validation:Enum=Surge;BlueGreen
+	// +kubebuilder:default=Surge
+	// +optional
+	Strategy NodePoolUpgradeStrategy `json:"strategy,omitempty"`
+	// Surge configures the surge upgrade strategy.
+	// +optional
+	Surge *SurgeUpgradeSettings `json:"surge,omitempty"`
+	// BlueGreen configures the blue-green upgrade strategy.
+	// +optional
+	BlueGreen *BlueGreenUpgradeSettings `json:"blueGreen,omitempty"`
+}
+
+// SurgeUpgradeSettings configures the surge upgrade strategy.
+type SurgeUpgradeSettings struct {
+	// MaxSurge is the maximum number of nodes that can be created beyond the size of the node pool during the upgrade.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxSurge int32 `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of nodes that can be unavailable at the same time during the upgrade.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxUnavailable int32 `json:"maxUnavailable,omitempty"`
+}
+
+// BlueGreenUpgradeSettings configures the blue-green upgrade strategy.
+type BlueGreenUpgradeSettings struct {
+	// BatchNodeCount is the number of blue nodes drained in a batch.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	BatchNodeCount *int32 `json:"batchNodeCount,omitempty"`
+	// BatchPercentage is the percentage of blue nodes drained in a batch.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// +optional
+	BatchPercentage *int32 `json:"batchPercentage,omitempty"`
+	// BatchSoakDuration is the time to wait after draining a batch before draining the next one.
+	// +optional
+	BatchSoakDuration *metav1.Duration `json:"batchSoakDuration,omitempty"`
+	// NodePoolSoakDuration is the time to wait after all the blue nodes are drained before deleting them.
+	// +optional
+	NodePoolSoakDuration *metav1.Duration `json:"nodePoolSoakDuration,omitempty"`
+}
+
+// NodePoolZoneStatus reports the nodes of a node pool in a zone.
+type NodePoolZoneStatus struct {
+	// Zone is the name of the zone.
+	Zone string `json:"zone"`
+	// Replicas is the number of instances in the zone.
+	Replicas int32 `json:"replicas"`
+	// ReadyReplicas is the number of instances in the zone that are running with no pending action.
+	ReadyReplicas int32 `json:"readyReplicas"`
+}
+
+// NodePoolUpgradeStatus reports the progress of a node pool upgrade.
+type NodePoolUpgradeStatus struct {
+	// Strategy is the upgrade strategy in use.
+	// +optional
+	Strategy NodePoolUpgradeStrategy `json:"strategy,omitempty"`
+	// Operation is the name of the GKE operation running the upgrade.
+	// +optional
+	Operation string `json:"operation,omitempty"`
+	// TargetVersion is the Kubernetes version the nodes are upgraded to.
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// Phase is the phase of a blue-green upgrade as reported by GKE, for example DRAINING_BLUE_POOL.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// CurrentBatch is the batch of nodes being upgraded, starting at 1.
+	// +optional
+	CurrentBatch int32 `json:"currentBatch,omitempty"`
+	// NodesUpgraded is the number of nodes already upgraded.
+	// +optional
+	NodesUpgraded int64 `json:"nodesUpgraded,omitempty"`
+	// NodesTotal is the number of nodes to upgrade.
+	// +optional
+	NodesTotal int64 `json:"nodesTotal,omitempty"`
+	// StartTime is the time the upgrade was requested.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
 // GCPManagedMachinePoolStatus defines the observed state of GCPManagedMachinePool.
 type GCPManagedMachinePoolStatus struct {
 	// Ready denotes that the GCPManagedMachinePool has joined the cluster
@@ -177,6 +410,21 @@ type GCPManagedMachinePoolStatus struct {
 	// Replicas is the most recently observed number of replicas.
 	// +optional
 	Replicas int32 `json:"replicas"`
+	// Zones reports the number of nodes in each zone of the node pool.
+	// +optional
+	Zones []NodePoolZoneStatus `json:"zones,omitempty"`
+	// Upgrade reports the progress of the node pool upgrade in progress, if any.
+	// +optional
+	Upgrade *NodePoolUpgradeStatus `json:"upgrade,omitempty"`
+	// Imported is true once an existing node pool has been adopted and the spec populated from it.
+	// +optional
+	Imported bool `json:"imported,omitempty"`
+	// Operation is the last GKE operation started on the node pool.
+	// +optional
+	Operation *GKEOperation `json:"operation,omitempty"`
+	// GKEConditions are the conditions reported by GKE on the node pool, which explain an error or degraded state.
+	// +optional
+	GKEConditions []GKEStatusCondition `json:"gkeConditions,omitempty"`
 	// Conditions specifies the cpnditions for the managed machine pool
 	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
 }
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -75,12 +76,55 @@ func (r *GCPManagedMachinePool) validateSpec() field.ErrorList {
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := r.validateNodeConfig(); errs != nil || len(errs) == 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := r.validateUpgradeSettings(); errs != nil || len(errs) == 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
 	return allErrs
 }
 
+// validateNodeConfig validates the node configuration of the GCPManagedMachinePool.
+func (r *GCPManagedMachinePool) validateNodeConfig() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.Spot && r.Spec.Preemptible {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "preemptible"), "cannot be set together with spot"))
+	}
+
+	if r.Spec.ReservationAffinity != nil {
+		reservationField := field.NewPath("spec", "reservationAffinity")
+		isSpecific := r.Spec.ReservationAffinity.ConsumeReservationType == SpecificReservation
+		if isSpecific && (r.Spec.ReservationAffinity.Key == "" || len(r.Spec.ReservationAffinity.Values) == 0) {
+			allErrs = append(allErrs, field.Required(reservationField, "key and values are required when consuming a specific reservation"))
+		}
+		if !isSpecific && (r.Spec.ReservationAffinity.Key != "" || len(r.Spec.ReservationAffinity.Values) != 0) {
+			allErrs = append(allErrs, field.Forbidden(reservationField, "key and values can only be set when consuming a specific reservation"))
+		}
+	}
+
+	if r.Spec.BootDiskKMSKey != nil && !kmsKeyNameRegexp.MatchString(*r.Spec.BootDiskKMSKey) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "bootDiskKMSKey"), *r.Spec.BootDiskKMSKey,
+			"must be in the form projects/[KEY_PROJECT_ID]/locations/[LOCATION]/keyRings/[RING_NAME]/cryptoKeys/[KEY_NAME]"))
+	}
+
+	if r.Spec.KubeletConfig != nil && r.Spec.KubeletConfig.CPUCFSQuotaPeriod != nil {
+		periodField := field.NewPath("spec", "kubeletConfig", "cpuCFSQuotaPeriod")
+		period, err := time.ParseDuration(*r.Spec.KubeletConfig.CPUCFSQuotaPeriod)
+		if err != nil || period < time.Millisecond || period > time.Second {
+			allErrs = append(allErrs, field.Invalid(periodField, *r.Spec.KubeletConfig.CPUCFSQuotaPeriod, "must be a duration between 1ms and 1s"))
+		}
+	}
+
+	return allErrs
+}
+
 // validateScaling validates that the GCPManagedMachinePool autoscaling spec is valid.
 func (r *GCPManagedMachinePool) validateScaling() field.ErrorList {
 	var allErrs field.ErrorList
@@ -123,6 +167,43 @@ func (r *GCPManagedMachinePool) validateScaling() field.ErrorList {
 	return allErrs
 }
 
+// validateUpgradeSettings validates that the GCPManagedMachinePool upgrade settings are valid.
+func (r *GCPManagedMachinePool) validateUpgradeSettings() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.UpgradeSettings == nil {
+		return allErrs
+	}
+
+	upgradeField := field.NewPath("spec", "upgradeSettings")
+	settings := r.Spec.UpgradeSettings
+	if settings.Strategy == BlueGreenUpgradeStrategy {
+		if settings.Surge != nil {
+			allErrs = append(allErrs, field.Forbidden(upgradeField.Child("surge"), "cannot be specified with the BlueGreen strategy"))
+		}
+		if blueGreen := settings.BlueGreen; blueGreen != nil {
+			if blueGreen.BatchNodeCount != nil && blueGreen.BatchPercentage != nil {
+				allErrs = append(allErrs, field.Forbidden(upgradeField.Child("blueGreen", "batchPercentage"), "cannot be specified with batchNodeCount"))
+			}
+			if blueGreen.BatchSoakDuration != nil && blueGreen.BatchSoakDuration.Duration < 0 {
+				allErrs = append(allErrs, field.Invalid(upgradeField.Child("blueGreen", "batchSoakDuration"), blueGreen.BatchSoakDuration.Duration.String(), "must be non-negative"))
+			}
+			if blueGreen.NodePoolSoakDuration != nil && blueGreen.NodePoolSoakDuration.Duration < 0 {
+				allErrs = append(allErrs, field.Invalid(upgradeField.Child("blueGreen", "nodePoolSoakDuration"), blueGreen.NodePoolSoakDuration.Duration.String(), "must be non-negative"))
+			}
+		}
+		return allErrs
+	}
+
+	if settings.BlueGreen != nil {
+		allErrs = append(allErrs, field.Forbidden(upgradeField.Child("blueGreen"), "can only be specified with the BlueGreen strategy"))
+	}
+	if settings.Surge != nil && settings.Surge.MaxSurge+settings.Surge.MaxUnavailable <= 0 {
+		allErrs = append(allErrs, field.Invalid(upgradeField.Child("surge"), settings.Surge, "maxSurge and maxUnavailable cannot both be zero"))
+	}
+
+	return allErrs
+}
+
 func appendErrorIfNegative[T int32 | int64](value *T, name string, errs *field.ErrorList) {
 	if value != nil && *value < 0 {
 		*errs = append(*errs, field.Invalid(field.NewPath("spec", name), *value, "must be non-negative"))
@@ -136,6 +217,7 @@ func (r *GCPManagedMachinePool) validateNonNegative() field.ErrorList {
 	appendErrorIfNegative(r.Spec.DiskSizeGb, "diskSizeGb", &allErrs)
 	appendErrorIfNegative(r.Spec.MaxPodsPerNode, "maxPodsPerNode", &allErrs)
 	appendErrorIfNegative(r.Spec.LocalSsdCount, "localSsdCount", &allErrs)
+	appendErrorIfNegative(r.Spec.ReplicasPerZone, "replicasPerZone", &allErrs)
 
 	return allErrs
 }
@@ -149,6 +231,34 @@ func appendErrorIfMutated(old, update interface{}, name string, errs *field.Erro
 	}
 }
 
+func appendErrorIfRemoved(wasSet, isSet bool, path *field.Path, errs *field.ErrorList) {
+	if wasSet && !isSet {
+		*errs = append(*errs, field.Forbidden(path, "field can't be removed once set"))
+	}
+}
+
+// validateNotRemoved validates that the node settings GKE keeps when an update doesn't specify them are not removed
+// from the GCPManagedMachinePool spec, as removing them would leave the nodes unchanged.
+func (r *GCPManagedMachinePool) validateNotRemoved(old *GCPManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	kubeletConfigPath := specPath.Child("kubeletConfig")
+	appendErrorIfRemoved(old.Spec.KubeletConfig != nil, r.Spec.KubeletConfig != nil, kubeletConfigPath, &allErrs)
+	if old.Spec.KubeletConfig != nil && r.Spec.KubeletConfig != nil {
+		oldKubeletConfig, kubeletConfig := old.Spec.KubeletConfig, r.Spec.KubeletConfig
+		appendErrorIfRemoved(oldKubeletConfig.CPUManagerPolicy != nil, kubeletConfig.CPUManagerPolicy != nil, kubeletConfigPath.Child("cpuManagerPolicy"), &allErrs)
+		appendErrorIfRemoved(oldKubeletConfig.CPUCFSQuota != nil, kubeletConfig.CPUCFSQuota != nil, kubeletConfigPath.Child("cpuCFSQuota"), &allErrs)
+		appendErrorIfRemoved(oldKubeletConfig.CPUCFSQuotaPeriod != nil, kubeletConfig.CPUCFSQuotaPeriod != nil, kubeletConfigPath.Child("cpuCFSQuotaPeriod"), &allErrs)
+		appendErrorIfRemoved(oldKubeletConfig.PodPidsLimit != nil, kubeletConfig.PodPidsLimit != nil, kubeletConfigPath.Child("podPidsLimit"), &allErrs)
+	}
+	appendErrorIfRemoved(old.Spec.WorkloadMetadataMode != nil, r.Spec.WorkloadMetadataMode != nil, specPath.Child("workloadMetadataMode"), &allErrs)
+	appendErrorIfRemoved(old.Spec.EnableGvnic != nil, r.Spec.EnableGvnic != nil, specPath.Child("enableGvnic"), &allErrs)
+	appendErrorIfRemoved(old.Spec.EnableConfidentialNodes != nil, r.Spec.EnableConfidentialNodes != nil, specPath.Child("enableConfidentialNodes"), &allErrs)
+
+	return allErrs
+}
+
 // validateImmutable validates that immutable GCPManagedMachinePool spec fields are not mutated.
 func (r *GCPManagedMachinePool) validateImmutable(old *GCPManagedMachinePool) field.ErrorList {
 	var allErrs field.ErrorList
@@ -165,6 +275,12 @@ func (r *GCPManagedMachinePool) validateImmutable(old *GCPManagedMachinePool) fi
 	appendErrorIfMutated(old.Spec.NodeNetwork.CreatePodRange, r.Spec.NodeNetwork.CreatePodRange, "createPodRange", &allErrs)
 	appendErrorIfMutated(old.Spec.NodeNetwork.PodRangeCidrBlock, r.Spec.NodeNetwork.PodRangeCidrBlock, "podRangeCidrBlock", &allErrs)
 	appendErrorIfMutated(old.Spec.NodeSecurity, r.Spec.NodeSecurity, "nodeSecurity", &allErrs)
+	appendErrorIfMutated(old.Spec.Spot, r.Spec.Spot, "spot", &allErrs)
+	appendErrorIfMutated(old.Spec.Preemptible, r.Spec.Preemptible, "preemptible", &allErrs)
+	appendErrorIfMutated(old.Spec.Accelerators, r.Spec.Accelerators, "accelerators", &allErrs)
+	appendErrorIfMutated(old.Spec.ReservationAffinity, r.Spec.ReservationAffinity, "reservationAffinity", &allErrs)
+	appendErrorIfMutated(old.Spec.BootDiskKMSKey, r.Spec.BootDiskKMSKey, "bootDiskKMSKey", &allErrs)
+	appendErrorIfMutated(old.Spec.Metadata, r.Spec.Metadata, "metadata", &allErrs)
 
 	return allErrs
 }
@@ -195,6 +311,10 @@ func (r *GCPManagedMachinePool) ValidateUpdate(oldRaw runtime.Object) (admission
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := r.validateNotRemoved(old); errs != nil {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if errs := r.validateSpec(); errs != nil || len(errs) == 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -19,8 +19,11 @@ package v1beta1
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 )
 
@@ -122,6 +125,129 @@ func TestGCPManagedMachinePoolValidatingWebhookCreate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "valid node config",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				Spot:         true,
+				Accelerators: []AcceleratorConfig{
+					{
+						Type:             "nvidia-tesla-t4",
+						Count:            1,
+						GPUDriverVersion: ptr.To(GPUDriverVersionLatest),
+					},
+				},
+				ReservationAffinity: &ReservationAffinity{
+					ConsumeReservationType: SpecificReservation,
+					Key:                    "compute.googleapis.com/reservation-name",
+					Values:                 []string{"gpu-reservation"},
+				},
+				BootDiskKMSKey: ptr.To("projects/my-project/locations/us-central1/keyRings/gke/cryptoKeys/boot"),
+				KubeletConfig: &KubeletConfig{
+					CPUManagerPolicy:  ptr.To("static"),
+					CPUCFSQuotaPeriod: ptr.To("50ms"),
+				},
+				WorkloadMetadataMode: ptr.To(GKEMetadata),
+			},
+			expectError: false,
+		},
+		{
+			name: "spot and preemptible",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				Spot:         true,
+				Preemptible:  true,
+			},
+			expectError: true,
+		},
+		{
+			name: "specific reservation without values",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				ReservationAffinity: &ReservationAffinity{
+					ConsumeReservationType: SpecificReservation,
+					Key:                    "compute.googleapis.com/reservation-name",
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid boot disk KMS key",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName:   "nodepool1",
+				BootDiskKMSKey: ptr.To("my-key"),
+			},
+			expectError: true,
+		},
+		{
+			name: "CPU CFS quota period out of range",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				KubeletConfig: &KubeletConfig{
+					CPUCFSQuotaPeriod: ptr.To("2s"),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "valid blue-green upgrade settings",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				UpgradeSettings: &NodePoolUpgradeSettings{
+					Strategy: BlueGreenUpgradeStrategy,
+					BlueGreen: &BlueGreenUpgradeSettings{
+						BatchPercentage:      ptr.To(int32(25)),
+						BatchSoakDuration:    &metav1.Duration{Duration: 10 * time.Minute},
+						NodePoolSoakDuration: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "surge settings with blue-green strategy",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				UpgradeSettings: &NodePoolUpgradeSettings{
+					Strategy: BlueGreenUpgradeStrategy,
+					Surge:    &SurgeUpgradeSettings{MaxSurge: 1},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "blue-green batch node count and percentage",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				UpgradeSettings: &NodePoolUpgradeSettings{
+					Strategy: BlueGreenUpgradeStrategy,
+					BlueGreen: &BlueGreenUpgradeSettings{
+						BatchNodeCount:  ptr.To(int32(2)),
+						BatchPercentage: ptr.To(int32(25)),
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "surge upgrade without surge or unavailable nodes",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				UpgradeSettings: &NodePoolUpgradeSettings{
+					Strategy: SurgeUpgradeStrategy,
+					Surge:    &SurgeUpgradeSettings{},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "negative replicas per zone",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName:    "nodepool1",
+				ReplicasPerZone: ptr.To(int32(-1)),
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -147,6 +273,7 @@ func TestGCPManagedMachinePoolValidatingWebhookCreate(t *testing.T) {
 func TestGCPManagedMachinePoolValidatingWebhookUpdate(t *testing.T) {
 	tests := []struct {
 		name        string
+		oldSpec     *GCPManagedMachinePoolSpec
 		spec        GCPManagedMachinePoolSpec
 		expectError bool
 	}{
@@ -167,6 +294,105 @@ func TestGCPManagedMachinePoolValidatingWebhookUpdate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "mutable node config fields are mutated",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName:         "nodepool1",
+				KubeletConfig:        &KubeletConfig{PodPidsLimit: ptr.To(int64(4096))},
+				WorkloadMetadataMode: ptr.To(GKEMetadata),
+				EnableGvnic:          ptr.To(true),
+				ResourceManagerTags:  map[string]string{"tagKeys/123": "tagValues/456"},
+			},
+			expectError: false,
+		},
+		{
+			name: "node config fields are changed",
+			oldSpec: &GCPManagedMachinePoolSpec{
+				NodePoolName:         "nodepool1",
+				KubeletConfig:        &KubeletConfig{PodPidsLimit: ptr.To(int64(4096))},
+				WorkloadMetadataMode: ptr.To(GKEMetadata),
+				EnableGvnic:          ptr.To(true),
+			},
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName:         "nodepool1",
+				KubeletConfig:        &KubeletConfig{PodPidsLimit: ptr.To(int64(8192)), CPUManagerPolicy: ptr.To("static")},
+				WorkloadMetadataMode: ptr.To(GCEMetadata),
+				EnableGvnic:          ptr.To(false),
+			},
+			expectError: false,
+		},
+		{
+			name: "kubelet config is removed",
+			oldSpec: &GCPManagedMachinePoolSpec{
+				NodePoolName:  "nodepool1",
+				KubeletConfig: &KubeletConfig{PodPidsLimit: ptr.To(int64(4096))},
+			},
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+			},
+			expectError: true,
+		},
+		{
+			name: "kubelet setting is removed",
+			oldSpec: &GCPManagedMachinePoolSpec{
+				NodePoolName:  "nodepool1",
+				KubeletConfig: &KubeletConfig{PodPidsLimit: ptr.To(int64(4096)), CPUCFSQuota: ptr.To(false)},
+			},
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName:  "nodepool1",
+				KubeletConfig: &KubeletConfig{PodPidsLimit: ptr.To(int64(4096))},
+			},
+			expectError: true,
+		},
+		{
+			name: "workload metadata mode is removed",
+			oldSpec: &GCPManagedMachinePoolSpec{
+				NodePoolName:         "nodepool1",
+				WorkloadMetadataMode: ptr.To(GKEMetadata),
+			},
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+			},
+			expectError: true,
+		},
+		{
+			name: "gVNIC is removed",
+			oldSpec: &GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				EnableGvnic:  ptr.To(true),
+			},
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+			},
+			expectError: true,
+		},
+		{
+			name: "confidential nodes are removed",
+			oldSpec: &GCPManagedMachinePoolSpec{
+				NodePoolName:            "nodepool1",
+				EnableConfidentialNodes: ptr.To(true),
+			},
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+			},
+			expectError: true,
+		},
+		{
+			name: "immutable field spot is mutated",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				Spot:         true,
+			},
+			expectError: true,
+		},
+		{
+			name: "immutable field accelerators is mutated",
+			spec: GCPManagedMachinePoolSpec{
+				NodePoolName: "nodepool1",
+				Accelerators: []AcceleratorConfig{{Type: "nvidia-l4", Count: 1}},
+			},
+			expectError: true,
+		},
 		{
 			name: "immutable field disk size is mutated",
 			spec: GCPManagedMachinePoolSpec{
@@ -189,6 +415,9 @@ func TestGCPManagedMachinePoolValidatingWebhookUpdate(t *testing.T) {
 					NodePoolName: "nodepool1",
 				},
 			}
+			if tc.oldSpec != nil {
+				oldMMP.Spec = *tc.oldSpec
+			}
 
 			warn, err := newMMP.ValidateUpdate(oldMMP)
 
@@ -17,9 +17,15 @@ limitations under the License.
 package v1beta1
 
 import (
+	"sort"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/container/apiv1/containerpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 // TaintEffect is the effect for a Kubernetes taint.
@@ -110,6 +116,57 @@ func ConvertToSdkAutoscaling(autoscaling *NodePoolAutoScaling) *containerpb.Node
 	return &sdkAutoscaling
 }
 
+// ConvertFromSdkTaint converts GCP SDK node taints to taints.
+func ConvertFromSdkTaint(taints []*containerpb.NodeTaint) Taints {
+	if taints == nil {
+		return nil
+	}
+	res := Taints{}
+	for _, taint := range taints {
+		var effect TaintEffect
+		switch taint.GetEffect() {
+		case containerpb.NodeTaint_NO_SCHEDULE:
+			effect = "NoSchedule"
+		case containerpb.NodeTaint_NO_EXECUTE:
+			effect = "NoExecute"
+		case containerpb.NodeTaint_PREFER_NO_SCHEDULE:
+			effect = "PreferNoSchedule"
+		}
+		res = append(res, Taint{
+			Key:    taint.GetKey(),
+			Value:  taint.GetValue(),
+			Effect: effect,
+		})
+	}
+	return res
+}
+
+// ConvertFromSdkAutoscaling converts GCP SDK node pool autoscaling config to the node pool autoscaling config.
+// Per-zone limits are converted to total limits across the zones of the node pool.
+func ConvertFromSdkAutoscaling(autoscaling *containerpb.NodePoolAutoscaling, zones int32) *NodePoolAutoScaling {
+	if !autoscaling.GetEnabled() {
+		return &NodePoolAutoScaling{
+			EnableAutoscaling: ptr.To(false),
+		}
+	}
+	minCount, maxCount := autoscaling.GetTotalMinNodeCount(), autoscaling.GetTotalMaxNodeCount()
+	if minCount == 0 && maxCount == 0 {
+		minCount, maxCount = autoscaling.GetMinNodeCount()*zones, autoscaling.GetMaxNodeCount()*zones
+	}
+	res := &NodePoolAutoScaling{
+		EnableAutoscaling: ptr.To(true),
+		MinCount:          ptr.To(minCount),
+		MaxCount:          ptr.To(maxCount),
+	}
+	switch autoscaling.GetLocationPolicy() {
+	case containerpb.NodePoolAutoscaling_BALANCED:
+		res.LocationPolicy = ptr.To(ManagedNodePoolLocationPolicyBalanced)
+	case containerpb.NodePoolAutoscaling_ANY:
+		res.LocationPolicy = ptr.To(ManagedNodePoolLocationPolicyAny)
+	}
+	return res
+}
+
 // ConvertFromSdkNodeVersion converts GCP SDK node version to k8s version.
 func ConvertFromSdkNodeVersion(sdkNodeVersion string) string {
 	// For example, the node version returned from GCP SDK can be 1.27.2-gke.2100, we want to convert it to 1.27.2
@@ -144,3 +201,276 @@ func ConvertToSdkLinuxNodeConfig(linuxNodeConfig *LinuxNodeConfig) *containerpb.
 	}
 	return &sdkLinuxNodeConfig
 }
+
+// ConvertFromSdkLinuxNodeConfig converts GCP SDK Linux node config to the Linux node config.
+func ConvertFromSdkLinuxNodeConfig(linuxNodeConfig *containerpb.LinuxNodeConfig) *LinuxNodeConfig {
+	if linuxNodeConfig == nil {
+		return nil
+	}
+	res := &LinuxNodeConfig{}
+	if linuxNodeConfig.GetSysctls() != nil {
+		parameters := make([]string, 0, len(linuxNodeConfig.GetSysctls()))
+		for parameter := range linuxNodeConfig.GetSysctls() {
+			parameters = append(parameters, parameter)
+		}
+		sort.Strings(parameters)
+		res.Sysctls = make([]SysctlConfig, 0, len(parameters))
+		for _, parameter := range parameters {
+			res.Sysctls = append(res.Sysctls, SysctlConfig{
+				Parameter: parameter,
+				Value:     linuxNodeConfig.GetSysctls()[parameter],
+			})
+		}
+	}
+	switch linuxNodeConfig.GetCgroupMode() {
+	case containerpb.LinuxNodeConfig_CGROUP_MODE_V1:
+		res.CgroupMode = ptr.To[ManagedNodePoolCgroupMode](1)
+	case containerpb.LinuxNodeConfig_CGROUP_MODE_V2:
+		res.CgroupMode = ptr.To[ManagedNodePoolCgroupMode](2)
+	}
+	return res
+}
+
+// ConvertToSdkAccelerators converts accelerators to format that is used by GCP SDK.
+func ConvertToSdkAccelerators(accelerators []AcceleratorConfig) []*containerpb.AcceleratorConfig {
+	if accelerators == nil {
+		return nil
+	}
+	res := []*containerpb.AcceleratorConfig{}
+	for _, accelerator := range accelerators {
+		sdkAccelerator := &containerpb.AcceleratorConfig{
+			AcceleratorType:  accelerator.Type,
+			AcceleratorCount: accelerator.Count,
+		}
+		if accelerator.GPUPartitionSize != nil {
+			sdkAccelerator.GpuPartitionSize = *accelerator.GPUPartitionSize
+		}
+		if accelerator.GPUDriverVersion != nil {
+			driverVersion := containerpb.GPUDriverInstallationConfig_GPU_DRIVER_VERSION_UNSPECIFIED
+			switch *accelerator.GPUDriverVersion {
+			case GPUDriverVersionDefault:
+				driverVersion = containerpb.GPUDriverInstallationConfig_DEFAULT
+			case GPUDriverVersionLatest:
+				driverVersion = containerpb.GPUDriverInstallationConfig_LATEST
+			case GPUDriverInstallationDisabled:
+				driverVersion = containerpb.GPUDriverInstallationConfig_INSTALLATION_DISABLED
+			}
+			sdkAccelerator.GpuDriverInstallationConfig = &containerpb.GPUDriverInstallationConfig{
+				GpuDriverVersion: &driverVersion,
+			}
+		}
+		res = append(res, sdkAccelerator)
+	}
+	return res
+}
+
+// ConvertToSdkReservationAffinity converts reservation affinity to format that is used by GCP SDK.
+func ConvertToSdkReservationAffinity(reservationAffinity *ReservationAffinity) *containerpb.ReservationAffinity {
+	if reservationAffinity == nil {
+		return nil
+	}
+	sdkReservationAffinity := containerpb.ReservationAffinity{
+		Key:    reservationAffinity.Key,
+		Values: reservationAffinity.Values,
+	}
+	switch reservationAffinity.ConsumeReservationType {
+	case NoReservation:
+		sdkReservationAffinity.ConsumeReservationType = containerpb.ReservationAffinity_NO_RESERVATION
+	case AnyReservation:
+		sdkReservationAffinity.ConsumeReservationType = containerpb.ReservationAffinity_ANY_RESERVATION
+	case SpecificReservation:
+		sdkReservationAffinity.ConsumeReservationType = containerpb.ReservationAffinity_SPECIFIC_RESERVATION
+	}
+	return &sdkReservationAffinity
+}
+
+// ConvertToSdkKubeletConfig converts kubelet config to format that is used by GCP SDK.
+func ConvertToSdkKubeletConfig(kubeletConfig *KubeletConfig) *containerpb.NodeKubeletConfig {
+	if kubeletConfig == nil {
+		return nil
+	}
+	sdkKubeletConfig := containerpb.NodeKubeletConfig{}
+	if kubeletConfig.CPUManagerPolicy != nil {
+		sdkKubeletConfig.CpuManagerPolicy = *kubeletConfig.CPUManagerPolicy
+	}
+	if kubeletConfig.CPUCFSQuota != nil {
+		sdkKubeletConfig.CpuCfsQuota = wrapperspb.Bool(*kubeletConfig.CPUCFSQuota)
+	}
+	if kubeletConfig.CPUCFSQuotaPeriod != nil {
+		sdkKubeletConfig.CpuCfsQuotaPeriod = *kubeletConfig.CPUCFSQuotaPeriod
+	}
+	if kubeletConfig.PodPidsLimit != nil {
+		sdkKubeletConfig.PodPidsLimit = *kubeletConfig.PodPidsLimit
+	}
+	return &sdkKubeletConfig
+}
+
+// ConvertToSdkWorkloadMetadataConfig converts workload metadata mode to format that is used by GCP SDK.
+func ConvertToSdkWorkloadMetadataConfig(mode *WorkloadMetadataMode) *containerpb.WorkloadMetadataConfig {
+	if mode == nil {
+		return nil
+	}
+	switch *mode {
+	case GKEMetadata:
+		return &containerpb.WorkloadMetadataConfig{Mode: containerpb.WorkloadMetadataConfig_GKE_METADATA}
+	case GCEMetadata:
+		return &containerpb.WorkloadMetadataConfig{Mode: containerpb.WorkloadMetadataConfig_GCE_METADATA}
+	}
+	return &containerpb.WorkloadMetadataConfig{Mode: containerpb.WorkloadMetadataConfig_MODE_UNSPECIFIED}
+}
+
+// ConvertToSdkUpgradeSettings converts node pool upgrade settings to format that is used by GCP SDK.
+func ConvertToSdkUpgradeSettings(upgradeSettings *NodePoolUpgradeSettings) *containerpb.NodePool_UpgradeSettings {
+	if upgradeSettings == nil {
+		return nil
+	}
+	if upgradeSettings.Strategy == BlueGreenUpgradeStrategy {
+		strategy := containerpb.NodePoolUpdateStrategy_BLUE_GREEN
+		sdkBlueGreenSettings := containerpb.BlueGreenSettings{}
+		standardRolloutPolicy := containerpb.BlueGreenSettings_StandardRolloutPolicy{}
+		if blueGreen := upgradeSettings.BlueGreen; blueGreen != nil {
+			switch {
+			case blueGreen.BatchNodeCount != nil:
+				standardRolloutPolicy.UpdateBatchSize = &containerpb.BlueGreenSettings_StandardRolloutPolicy_BatchNodeCount{
+					BatchNodeCount: *blueGreen.BatchNodeCount,
+				}
+			case blueGreen.BatchPercentage != nil:
+				standardRolloutPolicy.UpdateBatchSize = &containerpb.BlueGreenSettings_StandardRolloutPolicy_BatchPercentage{
+					BatchPercentage: float32(*blueGreen.BatchPercentage) / 100,
+				}
+			}
+			if blueGreen.BatchSoakDuration != nil {
+				standardRolloutPolicy.BatchSoakDuration = durationpb.New(blueGreen.BatchSoakDuration.Duration)
+			}
+			if blueGreen.NodePoolSoakDuration != nil {
+				sdkBlueGreenSettings.NodePoolSoakDuration = durationpb.New(blueGreen.NodePoolSoakDuration.Duration)
+			}
+		}
+		sdkBlueGreenSettings.RolloutPolicy = &containerpb.BlueGreenSettings_StandardRolloutPolicy_{
+			StandardRolloutPolicy: &standardRolloutPolicy,
+		}
+		return &containerpb.NodePool_UpgradeSettings{
+			Strategy:          &strategy,
+			BlueGreenSettings: &sdkBlueGreenSettings,
+		}
+	}
+
+	strategy := containerpb.NodePoolUpdateStrategy_SURGE
+	sdkUpgradeSettings := containerpb.NodePool_UpgradeSettings{
+		Strategy: &strategy,
+		MaxSurge: 1,
+	}
+	if upgradeSettings.Surge != nil {
+		sdkUpgradeSettings.MaxSurge = upgradeSettings.Surge.MaxSurge
+		sdkUpgradeSettings.MaxUnavailable = upgradeSettings.Surge.MaxUnavailable
+	}
+	return &sdkUpgradeSettings
+}
+
+// GKEOperation reports a GKE operation started on a cluster or node pool.
+type GKEOperation struct {
+	// Name is the name of the operation.
+	Name string `json:"name"`
+	// Type is the type of the operation, for example CREATE_CLUSTER or UPGRADE_NODES.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Status is the status of the operation, for example RUNNING or DONE.
+	// +optional
+	Status string `json:"status,omitempty"`
+	// Progress is the completion percentage of the operation, when reported by GKE.
+	// +optional
+	Progress int32 `json:"progress,omitempty"`
+	// StartTime is the time the operation started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time the operation completed.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// Error is the error message of a failed operation.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// GKEStatusCondition is a condition reported by GKE on a cluster or node pool.
+type GKEStatusCondition struct {
+	// Code is the canonical code of the condition, for example RESOURCE_EXHAUSTED.
+	// +optional
+	Code string `json:"code,omitempty"`
+	// Message is the message of the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ConvertFromSdkOperation converts a GCP SDK operation to the operation reported in status.
+func ConvertFromSdkOperation(operation *containerpb.Operation) *GKEOperation {
+	if operation == nil {
+		return nil
+	}
+
+	return &GKEOperation{
+		Name:      operation.GetName(),
+		Type:      operation.GetOperationType().String(),
+		Status:    operation.GetStatus().String(),
+		Progress:  operationProgressPercent(operation.GetProgress()),
+		StartTime: convertFromSdkTime(operation.GetStartTime()),
+		EndTime:   convertFromSdkTime(operation.GetEndTime()),
+		Error:     operation.GetError().GetMessage(),
+	}
+}
+
+// operationProgressPercent returns the completion percentage of an operation from its node metrics, or from its
+// stages when the nodes are not reported.
+func operationProgressPercent(progress *containerpb.OperationProgress) int32 {
+	var done, total int64
+	for _, metric := range progress.GetMetrics() {
+		switch metric.GetName() {
+		case "NODES_DONE":
+			done = metric.GetIntValue()
+		case "NODES_TOTAL":
+			total = metric.GetIntValue()
+		}
+	}
+	if total == 0 {
+		for _, stage := range progress.GetStages() {
+			total++
+			if stage.GetStatus() == containerpb.Operation_DONE {
+				done++
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+
+	return int32(min(done*100/total, 100)) //nolint:gosec
+}
+
+// convertFromSdkTime converts an RFC3339 timestamp returned by the GCP SDK, ignoring the ones that can't be parsed.
+func convertFromSdkTime(timestamp string) *metav1.Time {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return nil
+	}
+
+	return &metav1.Time{Time: t}
+}
+
+// ConvertFromSdkStatusConditions converts GCP SDK status conditions to the conditions reported in status.
+func ConvertFromSdkStatusConditions(statusConditions []*containerpb.StatusCondition) []GKEStatusCondition {
+	if len(statusConditions) == 0 {
+		return nil
+	}
+	res := make([]GKEStatusCondition, 0, len(statusConditions))
+	for _, condition := range statusConditions {
+		var statusCode string
+		if condition.GetCanonicalCode() != 0 {
+			statusCode = condition.GetCanonicalCode().String()
+		}
+		res = append(res, GKEStatusCondition{
+			Code:    statusCode,
+			Message: condition.GetMessage(),
+		})
+	}
+
+	return res
+}
@@ -21,11 +21,117 @@ limitations under the License.
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiv1beta1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
 	cluster_apiapiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcceleratorConfig) DeepCopyInto(out *AcceleratorConfig) {
+	*out = *in
+	if in.GPUPartitionSize != nil {
+		in, out := &in.GPUPartitionSize, &out.GPUPartitionSize
+		*out = new(string)
+		**out = **in
+	}
+	if in.GPUDriverVersion != nil {
+		in, out := &in.GPUDriverVersion, &out.GPUDriverVersion
+		*out = new(GPUDriverVersion)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcceleratorConfig.
+func (in *AcceleratorConfig) DeepCopy() *AcceleratorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AcceleratorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonsConfig) DeepCopyInto(out *AddonsConfig) {
+	*out = *in
+	if in.HTTPLoadBalancing != nil {
+		in, out := &in.HTTPLoadBalancing, &out.HTTPLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HorizontalPodAutoscaling != nil {
+		in, out := &in.HorizontalPodAutoscaling, &out.HorizontalPodAutoscaling
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GcePersistentDiskCsiDriver != nil {
+		in, out := &in.GcePersistentDiskCsiDriver, &out.GcePersistentDiskCsiDriver
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GcpFilestoreCsiDriver != nil {
+		in, out := &in.GcpFilestoreCsiDriver, &out.GcpFilestoreCsiDriver
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GcsFuseCsiDriver != nil {
+		in, out := &in.GcsFuseCsiDriver, &out.GcsFuseCsiDriver
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodeLocalDNS != nil {
+		in, out := &in.NodeLocalDNS, &out.NodeLocalDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfigConnector != nil {
+		in, out := &in.ConfigConnector, &out.ConfigConnector
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GkeBackupAgent != nil {
+		in, out := &in.GkeBackupAgent, &out.GkeBackupAgent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretManager != nil {
+		in, out := &in.SecretManager, &out.SecretManager
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonsConfig.
+func (in *AddonsConfig) DeepCopy() *AddonsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedDatapathObservability) DeepCopyInto(out *AdvancedDatapathObservability) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedDatapathObservability.
+func (in *AdvancedDatapathObservability) DeepCopy() *AdvancedDatapathObservability {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedDatapathObservability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticatorGroupConfig) DeepCopyInto(out *AuthenticatorGroupConfig) {
 	*out = *in
@@ -41,6 +147,198 @@ func (in *AuthenticatorGroupConfig) DeepCopy() *AuthenticatorGroupConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutopilotConfig) DeepCopyInto(out *AutopilotConfig) {
+	*out = *in
+	if in.WorkloadPolicies != nil {
+		in, out := &in.WorkloadPolicies, &out.WorkloadPolicies
+		*out = new(AutopilotWorkloadPolicies)
+		**out = **in
+	}
+	if in.ResourceManagerTags != nil {
+		in, out := &in.ResourceManagerTags, &out.ResourceManagerTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutopilotConfig.
+func (in *AutopilotConfig) DeepCopy() *AutopilotConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutopilotConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutopilotWorkloadPolicies) DeepCopyInto(out *AutopilotWorkloadPolicies) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutopilotWorkloadPolicies.
+func (in *AutopilotWorkloadPolicies) DeepCopy() *AutopilotWorkloadPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(AutopilotWorkloadPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoprovisioningNodePoolDefaults) DeepCopyInto(out *AutoprovisioningNodePoolDefaults) {
+	*out = *in
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.OAuthScopes != nil {
+		in, out := &in.OAuthScopes, &out.OAuthScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageType != nil {
+		in, out := &in.ImageType, &out.ImageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskSizeGb != nil {
+		in, out := &in.DiskSizeGb, &out.DiskSizeGb
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DiskType != nil {
+		in, out := &in.DiskType, &out.DiskType
+		*out = new(DiskType)
+		**out = **in
+	}
+	if in.BootDiskKMSKey != nil {
+		in, out := &in.BootDiskKMSKey, &out.BootDiskKMSKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoprovisioningNodePoolDefaults.
+func (in *AutoprovisioningNodePoolDefaults) DeepCopy() *AutoprovisioningNodePoolDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoprovisioningNodePoolDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinaryAuthorization) DeepCopyInto(out *BinaryAuthorization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinaryAuthorization.
+func (in *BinaryAuthorization) DeepCopy() *BinaryAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(BinaryAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenUpgradeSettings) DeepCopyInto(out *BlueGreenUpgradeSettings) {
+	*out = *in
+	if in.BatchNodeCount != nil {
+		in, out := &in.BatchNodeCount, &out.BatchNodeCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchPercentage != nil {
+		in, out := &in.BatchPercentage, &out.BatchPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchSoakDuration != nil {
+		in, out := &in.BatchSoakDuration, &out.BatchSoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NodePoolSoakDuration != nil {
+		in, out := &in.NodePoolSoakDuration, &out.NodePoolSoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenUpgradeSettings.
+func (in *BlueGreenUpgradeSettings) DeepCopy() *BlueGreenUpgradeSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenUpgradeSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscaling) DeepCopyInto(out *ClusterAutoscaling) {
+	*out = *in
+	if in.AutoscalingProfile != nil {
+		in, out := &in.AutoscalingProfile, &out.AutoscalingProfile
+		*out = new(AutoscalingProfile)
+		**out = **in
+	}
+	if in.ResourceLimits != nil {
+		in, out := &in.ResourceLimits, &out.ResourceLimits
+		*out = make([]ResourceLimit, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoprovisioningLocations != nil {
+		in, out := &in.AutoprovisioningLocations, &out.AutoprovisioningLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoprovisioningNodePoolDefaults != nil {
+		in, out := &in.AutoprovisioningNodePoolDefaults, &out.AutoprovisioningNodePoolDefaults
+		*out = new(AutoprovisioningNodePoolDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscaling.
+func (in *ClusterAutoscaling) DeepCopy() *ClusterAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDNSConfig) DeepCopyInto(out *ClusterDNSConfig) {
+	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(ClusterDNSScope)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDNSConfig.
+func (in *ClusterDNSConfig) DeepCopy() *ClusterDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterNetwork) DeepCopyInto(out *ClusterNetwork) {
 	*out = *in
@@ -59,6 +357,16 @@ func (in *ClusterNetwork) DeepCopyInto(out *ClusterNetwork) {
 		*out = new(ClusterNetworkService)
 		**out = **in
 	}
+	if in.DatapathProvider != nil {
+		in, out := &in.DatapathProvider, &out.DatapathProvider
+		*out = new(DatapathProvider)
+		**out = **in
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(ClusterDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetwork.
@@ -101,6 +409,107 @@ func (in *ClusterNetworkService) DeepCopy() *ClusterNetworkService {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigSyncConfig) DeepCopyInto(out *ConfigSyncConfig) {
+	*out = *in
+	out.Git = in.Git
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSyncConfig.
+func (in *ConfigSyncConfig) DeepCopy() *ConfigSyncConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigSyncConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigSyncGitConfig) DeepCopyInto(out *ConfigSyncGitConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSyncGitConfig.
+func (in *ConfigSyncGitConfig) DeepCopy() *ConfigSyncGitConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigSyncGitConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSEndpointConfig) DeepCopyInto(out *DNSEndpointConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSEndpointConfig.
+func (in *DNSEndpointConfig) DeepCopy() *DNSEndpointConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSEndpointConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DailyMaintenanceWindow) DeepCopyInto(out *DailyMaintenanceWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DailyMaintenanceWindow.
+func (in *DailyMaintenanceWindow) DeepCopy() *DailyMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DailyMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseEncryption) DeepCopyInto(out *DatabaseEncryption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseEncryption.
+func (in *DatabaseEncryption) DeepCopy() *DatabaseEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetConfig) DeepCopyInto(out *FleetConfig) {
+	*out = *in
+	if in.ConfigSync != nil {
+		in, out := &in.ConfigSync, &out.ConfigSync
+		*out = new(ConfigSyncConfig)
+		**out = **in
+	}
+	if in.PolicyController != nil {
+		in, out := &in.PolicyController, &out.PolicyController
+		*out = new(PolicyControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetConfig.
+func (in *FleetConfig) DeepCopy() *FleetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPManagedCluster) DeepCopyInto(out *GCPManagedCluster) {
 	*out = *in
@@ -323,6 +732,96 @@ func (in *GCPManagedControlPlaneSpec) DeepCopyInto(out *GCPManagedControlPlaneSp
 		*out = new(MonitoringService)
 		**out = **in
 	}
+	if in.LoggingConfig != nil {
+		in, out := &in.LoggingConfig, &out.LoggingConfig
+		*out = new(LoggingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MonitoringConfig != nil {
+		in, out := &in.MonitoringConfig, &out.MonitoringConfig
+		*out = new(MonitoringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceUsageExport != nil {
+		in, out := &in.ResourceUsageExport, &out.ResourceUsageExport
+		*out = new(ResourceUsageExportConfig)
+		**out = **in
+	}
+	if in.EnableCostAllocation != nil {
+		in, out := &in.EnableCostAllocation, &out.EnableCostAllocation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WorkloadIdentityConfig != nil {
+		in, out := &in.WorkloadIdentityConfig, &out.WorkloadIdentityConfig
+		*out = new(WorkloadIdentityConfig)
+		**out = **in
+	}
+	if in.AuthenticatorGroupConfig != nil {
+		in, out := &in.AuthenticatorGroupConfig, &out.AuthenticatorGroupConfig
+		*out = new(AuthenticatorGroupConfig)
+		**out = **in
+	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AddonsConfig != nil {
+		in, out := &in.AddonsConfig, &out.AddonsConfig
+		*out = new(AddonsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BinaryAuthorization != nil {
+		in, out := &in.BinaryAuthorization, &out.BinaryAuthorization
+		*out = new(BinaryAuthorization)
+		**out = **in
+	}
+	if in.EnableShieldedNodes != nil {
+		in, out := &in.EnableShieldedNodes, &out.EnableShieldedNodes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityPosture != nil {
+		in, out := &in.SecurityPosture, &out.SecurityPosture
+		*out = new(SecurityPosture)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseEncryption != nil {
+		in, out := &in.DatabaseEncryption, &out.DatabaseEncryption
+		*out = new(DatabaseEncryption)
+		**out = **in
+	}
+	if in.ClusterAutoscaling != nil {
+		in, out := &in.ClusterAutoscaling, &out.ClusterAutoscaling
+		*out = new(ClusterAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(KubeconfigConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSEndpoint != nil {
+		in, out := &in.DNSEndpoint, &out.DNSEndpoint
+		*out = new(DNSEndpointConfig)
+		**out = **in
+	}
+	if in.EndpointType != nil {
+		in, out := &in.EndpointType, &out.EndpointType
+		*out = new(ControlPlaneEndpointType)
+		**out = **in
+	}
+	if in.Autopilot != nil {
+		in, out := &in.Autopilot, &out.Autopilot
+		*out = new(AutopilotConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fleet != nil {
+		in, out := &in.Fleet, &out.Fleet
+		*out = new(FleetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedControlPlaneSpec.
@@ -345,6 +844,26 @@ func (in *GCPManagedControlPlaneStatus) DeepCopyInto(out *GCPManagedControlPlane
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AllowedVersions != nil {
+		in, out := &in.AllowedVersions, &out.AllowedVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(GKEOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GKEConditions != nil {
+		in, out := &in.GKEConditions, &out.GKEConditions
+		*out = make([]GKEStatusCondition, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedControlPlaneStatus.
@@ -434,6 +953,11 @@ func (in *GCPManagedMachinePoolSpec) DeepCopyInto(out *GCPManagedMachinePoolSpec
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ReplicasPerZone != nil {
+		in, out := &in.ReplicasPerZone, &out.ReplicasPerZone
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Scaling != nil {
 		in, out := &in.Scaling, &out.Scaling
 		*out = new(NodePoolAutoScaling)
@@ -454,112 +978,379 @@ func (in *GCPManagedMachinePoolSpec) DeepCopyInto(out *GCPManagedMachinePoolSpec
 		*out = new(string)
 		**out = **in
 	}
-	if in.DiskType != nil {
-		in, out := &in.DiskType, &out.DiskType
-		*out = new(DiskType)
+	if in.DiskType != nil {
+		in, out := &in.DiskType, &out.DiskType
+		*out = new(DiskType)
+		**out = **in
+	}
+	if in.DiskSizeGB != nil {
+		in, out := &in.DiskSizeGB, &out.DiskSizeGB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxPodsPerNode != nil {
+		in, out := &in.MaxPodsPerNode, &out.MaxPodsPerNode
+		*out = new(int64)
+		**out = **in
+	}
+	in.NodeNetwork.DeepCopyInto(&out.NodeNetwork)
+	in.NodeSecurity.DeepCopyInto(&out.NodeSecurity)
+	if in.KubernetesLabels != nil {
+		in, out := &in.KubernetesLabels, &out.KubernetesLabels
+		*out = make(apiv1beta1.Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KubernetesTaints != nil {
+		in, out := &in.KubernetesTaints, &out.KubernetesTaints
+		*out = make(Taints, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalLabels != nil {
+		in, out := &in.AdditionalLabels, &out.AdditionalLabels
+		*out = make(apiv1beta1.Labels, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Management != nil {
+		in, out := &in.Management, &out.Management
+		*out = new(NodePoolManagement)
+		**out = **in
+	}
+	if in.LinuxNodeConfig != nil {
+		in, out := &in.LinuxNodeConfig, &out.LinuxNodeConfig
+		*out = new(LinuxNodeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Accelerators != nil {
+		in, out := &in.Accelerators, &out.Accelerators
+		*out = make([]AcceleratorConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReservationAffinity != nil {
+		in, out := &in.ReservationAffinity, &out.ReservationAffinity
+		*out = new(ReservationAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootDiskKMSKey != nil {
+		in, out := &in.BootDiskKMSKey, &out.BootDiskKMSKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubeletConfig != nil {
+		in, out := &in.KubeletConfig, &out.KubeletConfig
+		*out = new(KubeletConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.WorkloadMetadataMode != nil {
+		in, out := &in.WorkloadMetadataMode, &out.WorkloadMetadataMode
+		*out = new(WorkloadMetadataMode)
+		**out = **in
+	}
+	if in.EnableGvnic != nil {
+		in, out := &in.EnableGvnic, &out.EnableGvnic
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableConfidentialNodes != nil {
+		in, out := &in.EnableConfidentialNodes, &out.EnableConfidentialNodes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourceManagerTags != nil {
+		in, out := &in.ResourceManagerTags, &out.ResourceManagerTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UpgradeSettings != nil {
+		in, out := &in.UpgradeSettings, &out.UpgradeSettings
+		*out = new(NodePoolUpgradeSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedMachinePoolSpec.
+func (in *GCPManagedMachinePoolSpec) DeepCopy() *GCPManagedMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPManagedMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPManagedMachinePoolStatus) DeepCopyInto(out *GCPManagedMachinePoolStatus) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]NodePoolZoneStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(NodePoolUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(GKEOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GKEConditions != nil {
+		in, out := &in.GKEConditions, &out.GKEConditions
+		*out = make([]GKEStatusCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(cluster_apiapiv1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedMachinePoolStatus.
+func (in *GCPManagedMachinePoolStatus) DeepCopy() *GCPManagedMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPManagedMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEOperation) DeepCopyInto(out *GKEOperation) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEOperation.
+func (in *GKEOperation) DeepCopy() *GKEOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEStatusCondition) DeepCopyInto(out *GKEStatusCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEStatusCondition.
+func (in *GKEStatusCondition) DeepCopy() *GKEStatusCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEStatusCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigConfig) DeepCopyInto(out *KubeconfigConfig) {
+	*out = *in
+	if in.ServiceAccountTokenTTL != nil {
+		in, out := &in.ServiceAccountTokenTTL, &out.ServiceAccountTokenTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigConfig.
+func (in *KubeconfigConfig) DeepCopy() *KubeconfigConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	if in.CPUManagerPolicy != nil {
+		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUCFSQuota != nil {
+		in, out := &in.CPUCFSQuota, &out.CPUCFSQuota
+		*out = new(bool)
 		**out = **in
 	}
-	if in.DiskSizeGB != nil {
-		in, out := &in.DiskSizeGB, &out.DiskSizeGB
-		*out = new(int64)
+	if in.CPUCFSQuotaPeriod != nil {
+		in, out := &in.CPUCFSQuotaPeriod, &out.CPUCFSQuotaPeriod
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxPodsPerNode != nil {
-		in, out := &in.MaxPodsPerNode, &out.MaxPodsPerNode
+	if in.PodPidsLimit != nil {
+		in, out := &in.PodPidsLimit, &out.PodPidsLimit
 		*out = new(int64)
 		**out = **in
 	}
-	in.NodeNetwork.DeepCopyInto(&out.NodeNetwork)
-	in.NodeSecurity.DeepCopyInto(&out.NodeSecurity)
-	if in.KubernetesLabels != nil {
-		in, out := &in.KubernetesLabels, &out.KubernetesLabels
-		*out = make(apiv1beta1.Labels, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
 	}
-	if in.KubernetesTaints != nil {
-		in, out := &in.KubernetesTaints, &out.KubernetesTaints
-		*out = make(Taints, len(*in))
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinuxNodeConfig) DeepCopyInto(out *LinuxNodeConfig) {
+	*out = *in
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make([]SysctlConfig, len(*in))
 		copy(*out, *in)
 	}
-	if in.AdditionalLabels != nil {
-		in, out := &in.AdditionalLabels, &out.AdditionalLabels
-		*out = make(apiv1beta1.Labels, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Management != nil {
-		in, out := &in.Management, &out.Management
-		*out = new(NodePoolManagement)
+	if in.CgroupMode != nil {
+		in, out := &in.CgroupMode, &out.CgroupMode
+		*out = new(ManagedNodePoolCgroupMode)
 		**out = **in
 	}
-	if in.LinuxNodeConfig != nil {
-		in, out := &in.LinuxNodeConfig, &out.LinuxNodeConfig
-		*out = new(LinuxNodeConfig)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinuxNodeConfig.
+func (in *LinuxNodeConfig) DeepCopy() *LinuxNodeConfig {
+	if in == nil {
+		return nil
 	}
-	if in.ProviderIDList != nil {
-		in, out := &in.ProviderIDList, &out.ProviderIDList
-		*out = make([]string, len(*in))
+	out := new(LinuxNodeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
+	*out = *in
+	if in.EnableComponents != nil {
+		in, out := &in.EnableComponents, &out.EnableComponents
+		*out = make([]LoggingComponent, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedMachinePoolSpec.
-func (in *GCPManagedMachinePoolSpec) DeepCopy() *GCPManagedMachinePoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfig.
+func (in *LoggingConfig) DeepCopy() *LoggingConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPManagedMachinePoolSpec)
+	out := new(LoggingConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPManagedMachinePoolStatus) DeepCopyInto(out *GCPManagedMachinePoolStatus) {
+func (in *MaintenanceExclusion) DeepCopyInto(out *MaintenanceExclusion) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make(cluster_apiapiv1beta1.Conditions, len(*in))
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(MaintenanceExclusionScope)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceExclusion.
+func (in *MaintenanceExclusion) DeepCopy() *MaintenanceExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicy) DeepCopyInto(out *MaintenancePolicy) {
+	*out = *in
+	if in.DailyMaintenanceWindow != nil {
+		in, out := &in.DailyMaintenanceWindow, &out.DailyMaintenanceWindow
+		*out = new(DailyMaintenanceWindow)
+		**out = **in
+	}
+	if in.RecurringWindow != nil {
+		in, out := &in.RecurringWindow, &out.RecurringWindow
+		*out = new(RecurringMaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceExclusions != nil {
+		in, out := &in.MaintenanceExclusions, &out.MaintenanceExclusions
+		*out = make([]MaintenanceExclusion, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPManagedMachinePoolStatus.
-func (in *GCPManagedMachinePoolStatus) DeepCopy() *GCPManagedMachinePoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicy.
+func (in *MaintenancePolicy) DeepCopy() *MaintenancePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(GCPManagedMachinePoolStatus)
+	out := new(MaintenancePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LinuxNodeConfig) DeepCopyInto(out *LinuxNodeConfig) {
+func (in *MaintenancePolicyStatus) DeepCopyInto(out *MaintenancePolicyStatus) {
 	*out = *in
-	if in.Sysctls != nil {
-		in, out := &in.Sysctls, &out.Sysctls
-		*out = make([]SysctlConfig, len(*in))
+	if in.ActiveExclusions != nil {
+		in, out := &in.ActiveExclusions, &out.ActiveExclusions
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.CgroupMode != nil {
-		in, out := &in.CgroupMode, &out.CgroupMode
-		*out = new(ManagedNodePoolCgroupMode)
-		**out = **in
+	if in.NextMaintenanceTime != nil {
+		in, out := &in.NextMaintenanceTime, &out.NextMaintenanceTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinuxNodeConfig.
-func (in *LinuxNodeConfig) DeepCopy() *LinuxNodeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyStatus.
+func (in *MaintenancePolicyStatus) DeepCopy() *MaintenancePolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LinuxNodeConfig)
+	out := new(MaintenancePolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -610,6 +1401,36 @@ func (in *MasterAuthorizedNetworksConfigCidrBlock) DeepCopy() *MasterAuthorizedN
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
+	*out = *in
+	if in.EnableComponents != nil {
+		in, out := &in.EnableComponents, &out.EnableComponents
+		*out = make([]MonitoringComponent, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableManagedPrometheus != nil {
+		in, out := &in.EnableManagedPrometheus, &out.EnableManagedPrometheus
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdvancedDatapathObservability != nil {
+		in, out := &in.AdvancedDatapathObservability, &out.AdvancedDatapathObservability
+		*out = new(AdvancedDatapathObservability)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringConfig.
+func (in *MonitoringConfig) DeepCopy() *MonitoringConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeNetworkConfig) DeepCopyInto(out *NodeNetworkConfig) {
 	*out = *in
@@ -695,6 +1516,65 @@ func (in *NodePoolManagement) DeepCopy() *NodePoolManagement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolUpgradeSettings) DeepCopyInto(out *NodePoolUpgradeSettings) {
+	*out = *in
+	if in.Surge != nil {
+		in, out := &in.Surge, &out.Surge
+		*out = new(SurgeUpgradeSettings)
+		**out = **in
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenUpgradeSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolUpgradeSettings.
+func (in *NodePoolUpgradeSettings) DeepCopy() *NodePoolUpgradeSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolUpgradeSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolUpgradeStatus) DeepCopyInto(out *NodePoolUpgradeStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolUpgradeStatus.
+func (in *NodePoolUpgradeStatus) DeepCopy() *NodePoolUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolZoneStatus) DeepCopyInto(out *NodePoolZoneStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolZoneStatus.
+func (in *NodePoolZoneStatus) DeepCopy() *NodePoolZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeSecurityConfig) DeepCopyInto(out *NodeSecurityConfig) {
 	*out = *in
@@ -726,6 +1606,31 @@ func (in *NodeSecurityConfig) DeepCopy() *NodeSecurityConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyControllerConfig) DeepCopyInto(out *PolicyControllerConfig) {
+	*out = *in
+	if in.AuditIntervalSeconds != nil {
+		in, out := &in.AuditIntervalSeconds, &out.AuditIntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ExemptableNamespaces != nil {
+		in, out := &in.ExemptableNamespaces, &out.ExemptableNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyControllerConfig.
+func (in *PolicyControllerConfig) DeepCopy() *PolicyControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateCluster) DeepCopyInto(out *PrivateCluster) {
 	*out = *in
@@ -741,6 +1646,98 @@ func (in *PrivateCluster) DeepCopy() *PrivateCluster {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecurringMaintenanceWindow) DeepCopyInto(out *RecurringMaintenanceWindow) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecurringMaintenanceWindow.
+func (in *RecurringMaintenanceWindow) DeepCopy() *RecurringMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(RecurringMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationAffinity) DeepCopyInto(out *ReservationAffinity) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationAffinity.
+func (in *ReservationAffinity) DeepCopy() *ReservationAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceLimit) DeepCopyInto(out *ResourceLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLimit.
+func (in *ResourceLimit) DeepCopy() *ResourceLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceUsageExportConfig) DeepCopyInto(out *ResourceUsageExportConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceUsageExportConfig.
+func (in *ResourceUsageExportConfig) DeepCopy() *ResourceUsageExportConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUsageExportConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPosture) DeepCopyInto(out *SecurityPosture) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(SecurityPostureMode)
+		**out = **in
+	}
+	if in.VulnerabilityMode != nil {
+		in, out := &in.VulnerabilityMode, &out.VulnerabilityMode
+		*out = new(SecurityPostureMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPosture.
+func (in *SecurityPosture) DeepCopy() *SecurityPosture {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPosture)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
 	*out = *in
@@ -766,6 +1763,21 @@ func (in *ServiceAccountConfig) DeepCopy() *ServiceAccountConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SurgeUpgradeSettings) DeepCopyInto(out *SurgeUpgradeSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SurgeUpgradeSettings.
+func (in *SurgeUpgradeSettings) DeepCopy() *SurgeUpgradeSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SurgeUpgradeSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SysctlConfig) DeepCopyInto(out *SysctlConfig) {
 	*out = *in
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta1"
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta2"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1exp.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1beta2.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+
+	t.Run("for GCPManagedCluster", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme: scheme,
+		Hub:    &infrav1exp.GCPManagedCluster{},
+		Spoke:  &GCPManagedCluster{},
+	}))
+
+	t.Run("for GCPManagedControlPlane", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme: scheme,
+		Hub:    &infrav1exp.GCPManagedControlPlane{},
+		Spoke:  &GCPManagedControlPlane{},
+	}))
+
+	t.Run("for GCPManagedMachinePool", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Scheme: scheme,
+		Hub:    &infrav1exp.GCPManagedMachinePool{},
+		Spoke:  &GCPManagedMachinePool{},
+	}))
+}
+
+func TestConvertGCPManagedMachinePoolSpec(t *testing.T) {
+	tests := []struct {
+		name            string
+		spec            infrav1exp.GCPManagedMachinePoolSpec
+		wantMachineType *string
+		wantDiskSizeGB  *int64
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "machine type and disk size",
+			spec: infrav1exp.GCPManagedMachinePoolSpec{
+				MachineType: ptr.To("e2-standard-4"),
+				DiskSizeGb:  ptr.To[int32](50),
+			},
+			wantMachineType: ptr.To("e2-standard-4"),
+			wantDiskSizeGB:  ptr.To[int64](50),
+		},
+		{
+			name: "instance type and disk size GB take precedence",
+			spec: infrav1exp.GCPManagedMachinePoolSpec{
+				MachineType:  ptr.To("e2-standard-4"),
+				InstanceType: ptr.To("n2-standard-8"),
+				DiskSizeGb:   ptr.To[int32](50),
+				DiskSizeGB:   ptr.To[int64](200),
+			},
+			wantMachineType: ptr.To("n2-standard-8"),
+			wantDiskSizeGB:  ptr.To[int64](200),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			hub := &infrav1exp.GCPManagedMachinePool{Spec: tt.spec}
+
+			spoke := &GCPManagedMachinePool{}
+			g.Expect(spoke.ConvertFrom(hub)).To(Succeed())
+			g.Expect(spoke.Spec.MachineType).To(Equal(tt.wantMachineType))
+			g.Expect(spoke.Spec.DiskSizeGB).To(Equal(tt.wantDiskSizeGB))
+
+			// Changing the merged fields in v1beta2 drops the duplicate v1beta1 fields.
+			spoke.Spec.MachineType = ptr.To("c3-standard-4")
+			spoke.Spec.DiskSizeGB = ptr.To[int64](100)
+			converted := &infrav1exp.GCPManagedMachinePool{}
+			g.Expect(spoke.ConvertTo(converted)).To(Succeed())
+			g.Expect(converted.Spec.MachineType).To(Equal(ptr.To("c3-standard-4")))
+			g.Expect(converted.Spec.InstanceType).To(BeNil())
+			g.Expect(converted.Spec.DiskSizeGB).To(Equal(ptr.To[int64](100)))
+			g.Expect(converted.Spec.DiskSizeGb).To(BeNil())
+		})
+	}
+}
+
+func TestConvertGCPManagedMachinePoolProvisioningModel(t *testing.T) {
+	tests := []struct {
+		name        string
+		spot        bool
+		preemptible bool
+		want        *infrav1beta2.ProvisioningModel
+	}{
+		{
+			name: "standard",
+		},
+		{
+			name: "spot",
+			spot: true,
+			want: ptr.To(infrav1beta2.ProvisioningModelSpot),
+		},
+		{
+			name:        "preemptible",
+			preemptible: true,
+			want:        ptr.To(infrav1beta2.ProvisioningModelPreemptible),
+		},
+		{
+			name:        "spot takes precedence",
+			spot:        true,
+			preemptible: true,
+			want:        ptr.To(infrav1beta2.ProvisioningModelSpot),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			hub := &infrav1exp.GCPManagedMachinePool{
+				Spec: infrav1exp.GCPManagedMachinePoolSpec{
+					Spot:        tt.spot,
+					Preemptible: tt.preemptible,
+				},
+			}
+
+			spoke := &GCPManagedMachinePool{}
+			g.Expect(spoke.ConvertFrom(hub)).To(Succeed())
+			g.Expect(spoke.Spec.ProvisioningModel).To(Equal(tt.want))
+
+			converted := &infrav1exp.GCPManagedMachinePool{}
+			g.Expect(spoke.DeepCopy().ConvertTo(converted)).To(Succeed())
+			g.Expect(converted.Spec.Spot).To(Equal(tt.spot))
+			g.Expect(converted.Spec.Preemptible).To(Equal(tt.preemptible))
+
+			// Changing the provisioning model in v1beta2 replaces both v1beta1 fields.
+			spoke.Spec.ProvisioningModel = ptr.To(infrav1beta2.ProvisioningModelPreemptible)
+			converted = &infrav1exp.GCPManagedMachinePool{}
+			g.Expect(spoke.ConvertTo(converted)).To(Succeed())
+			g.Expect(converted.Spec.Spot).To(BeFalse())
+			g.Expect(converted.Spec.Preemptible).To(BeTrue())
+		})
+	}
+
+	t.Run("explicit standard is preserved", func(t *testing.T) {
+		g := NewWithT(t)
+		spoke := &GCPManagedMachinePool{
+			Spec: GCPManagedMachinePoolSpec{
+				ProvisioningModel: ptr.To(infrav1beta2.ProvisioningModelStandard),
+			},
+		}
+
+		hub := &infrav1exp.GCPManagedMachinePool{}
+		g.Expect(spoke.ConvertTo(hub)).To(Succeed())
+		g.Expect(hub.Spec.Spot).To(BeFalse())
+		g.Expect(hub.Spec.Preemptible).To(BeFalse())
+
+		converted := &GCPManagedMachinePool{}
+		g.Expect(converted.ConvertFrom(hub.DeepCopy())).To(Succeed())
+		g.Expect(converted.Spec.ProvisioningModel).To(Equal(ptr.To(infrav1beta2.ProvisioningModelStandard)))
+
+		// Setting Spot in v1beta1 wins over the preserved provisioning model.
+		hub.Spec.Spot = true
+		converted = &GCPManagedMachinePool{}
+		g.Expect(converted.ConvertFrom(hub)).To(Succeed())
+		g.Expect(converted.Spec.ProvisioningModel).To(Equal(ptr.To(infrav1beta2.ProvisioningModelSpot)))
+	})
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this GCPManagedControlPlane to the Hub version (v1beta1).
+func (src *GCPManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1exp.GCPManagedControlPlane)
+	return Convert_v1beta2_GCPManagedControlPlane_To_v1beta1_GCPManagedControlPlane(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPManagedControlPlane) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1exp.GCPManagedControlPlane)
+	return Convert_v1beta1_GCPManagedControlPlane_To_v1beta2_GCPManagedControlPlane(src, dst, nil)
+}
+
+// ConvertTo converts this GCPManagedControlPlaneList to the Hub version (v1beta1).
+func (src *GCPManagedControlPlaneList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1exp.GCPManagedControlPlaneList)
+	return Convert_v1beta2_GCPManagedControlPlaneList_To_v1beta1_GCPManagedControlPlaneList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPManagedControlPlaneList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1exp.GCPManagedControlPlaneList)
+	return Convert_v1beta1_GCPManagedControlPlaneList_To_v1beta2_GCPManagedControlPlaneList(src, dst, nil)
+}
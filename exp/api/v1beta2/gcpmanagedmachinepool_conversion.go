@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta2"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-gcp/exp/api/v1beta1"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this GCPManagedMachinePool to the Hub version (v1beta1).
+func (src *GCPManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1exp.GCPManagedMachinePool)
+	if err := Convert_v1beta2_GCPManagedMachinePool_To_v1beta1_GCPManagedMachinePool(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1exp.GCPManagedMachinePool{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	// The duplicate v1beta1 fields are restored as long as the value they were merged into was not changed.
+	if ok {
+		if ptr.Equal(src.Spec.MachineType, convertToMachineType(&restored.Spec)) {
+			dst.Spec.MachineType = restored.Spec.MachineType
+			dst.Spec.InstanceType = restored.Spec.InstanceType
+		}
+		if ptr.Equal(src.Spec.DiskSizeGB, convertToDiskSizeGB(&restored.Spec)) {
+			dst.Spec.DiskSizeGb = restored.Spec.DiskSizeGb
+			dst.Spec.DiskSizeGB = restored.Spec.DiskSizeGB
+		}
+		if ptr.Equal(src.Spec.ProvisioningModel, convertToProvisioningModel(&restored.Spec)) {
+			dst.Spec.Spot = restored.Spec.Spot
+			dst.Spec.Preemptible = restored.Spec.Preemptible
+		}
+	}
+
+	// Spot and Preemptible cannot express an explicit Standard provisioning model, so it is preserved on the Hub.
+	if !ptr.Equal(src.Spec.ProvisioningModel, convertToProvisioningModel(&dst.Spec)) {
+		return utilconversion.MarshalData(src, dst)
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPManagedMachinePool) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1exp.GCPManagedMachinePool)
+	if err := Convert_v1beta1_GCPManagedMachinePool_To_v1beta2_GCPManagedMachinePool(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Restore the provisioning model preserved on up-conversion, as long as neither Spot nor Preemptible was set since.
+	restored := &GCPManagedMachinePool{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+	if ok && dst.Spec.ProvisioningModel == nil {
+		dst.Spec.ProvisioningModel = restored.Spec.ProvisioningModel
+	}
+
+	// Preserve Hub data on down-conversion.
+	return utilconversion.MarshalData(src, dst)
+}
+
+// ConvertTo converts this GCPManagedMachinePoolList to the Hub version (v1beta1).
+func (src *GCPManagedMachinePoolList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*infrav1exp.GCPManagedMachinePoolList)
+	return Convert_v1beta2_GCPManagedMachinePoolList_To_v1beta1_GCPManagedMachinePoolList(src, dst, nil)
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *GCPManagedMachinePoolList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*infrav1exp.GCPManagedMachinePoolList)
+	return Convert_v1beta1_GCPManagedMachinePoolList_To_v1beta2_GCPManagedMachinePoolList(src, dst, nil)
+}
+
+// Convert_v1beta1_GCPManagedMachinePoolSpec_To_v1beta2_GCPManagedMachinePoolSpec merges the duplicate InstanceType
+// and DiskSizeGb fields into MachineType and DiskSizeGB, and folds Spot and Preemptible into ProvisioningModel.
+func Convert_v1beta1_GCPManagedMachinePoolSpec_To_v1beta2_GCPManagedMachinePoolSpec(in *infrav1exp.GCPManagedMachinePoolSpec, out *GCPManagedMachinePoolSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta1_GCPManagedMachinePoolSpec_To_v1beta2_GCPManagedMachinePoolSpec(in, out, s); err != nil {
+		return err
+	}
+	out.MachineType = convertToMachineType(in)
+	out.DiskSizeGB = convertToDiskSizeGB(in)
+	out.ProvisioningModel = convertToProvisioningModel(in)
+
+	return nil
+}
+
+// Convert_v1beta2_GCPManagedMachinePoolSpec_To_v1beta1_GCPManagedMachinePoolSpec converts ProvisioningModel back to
+// the Spot and Preemptible fields.
+func Convert_v1beta2_GCPManagedMachinePoolSpec_To_v1beta1_GCPManagedMachinePoolSpec(in *GCPManagedMachinePoolSpec, out *infrav1exp.GCPManagedMachinePoolSpec, s apiconversion.Scope) error {
+	if err := autoConvert_v1beta2_GCPManagedMachinePoolSpec_To_v1beta1_GCPManagedMachinePoolSpec(in, out, s); err != nil {
+		return err
+	}
+	switch ptr.Deref(in.ProvisioningModel, "") {
+	case infrav1.ProvisioningModelSpot:
+		out.Spot = true
+	case infrav1.ProvisioningModelPreemptible:
+		out.Preemptible = true
+	}
+
+	return nil
+}
+
+// convertToMachineType returns the machine type of a v1beta1 node pool, where InstanceType takes precedence over
+// MachineType as it does when the node pool is created.
+func convertToMachineType(spec *infrav1exp.GCPManagedMachinePoolSpec) *string {
+	if spec.InstanceType != nil {
+		return spec.InstanceType
+	}
+
+	return spec.MachineType
+}
+
+// convertToDiskSizeGB returns the disk size of a v1beta1 node pool, where DiskSizeGB takes precedence over
+// DiskSizeGb as it does when the node pool is created.
+func convertToDiskSizeGB(spec *infrav1exp.GCPManagedMachinePoolSpec) *int64 {
+	if spec.DiskSizeGB != nil {
+		return spec.DiskSizeGB
+	}
+	if spec.DiskSizeGb != nil {
+		return ptr.To(int64(*spec.DiskSizeGb))
+	}
+
+	return nil
+}
+
+// convertToProvisioningModel returns the provisioning model of a v1beta1 node pool, where Spot takes precedence over
+// Preemptible. Node pools that are neither have no provisioning model, as the Standard one is the default.
+func convertToProvisioningModel(spec *infrav1exp.GCPManagedMachinePoolSpec) *infrav1.ProvisioningModel {
+	switch {
+	case spec.Spot:
+		return ptr.To(infrav1.ProvisioningModelSpot)
+	case spec.Preemptible:
+		return ptr.To(infrav1.ProvisioningModelPreemptible)
+	default:
+		return nil
+	}
+}
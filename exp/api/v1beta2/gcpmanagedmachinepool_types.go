@@ -0,0 +1,523 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-gcp/api/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// ManagedMachinePoolFinalizer allows Reconcile to clean up GCP resources associated with the GCPManagedMachinePool before
+	// removing it from the apiserver.
+	ManagedMachinePoolFinalizer = "gcpmanagedmachinepool.infrastructure.cluster.x-k8s.io"
+)
+
+// DiskType is type of the disk attached to node.
+// +kubebuilder:validation:Enum=pd-standard;pd-ssd;pd-balanced
+type DiskType string
+
+const (
+	// Standard disk type.
+	Standard DiskType = "pd-standard"
+	// SSD disk type.
+	SSD DiskType = "pd-ssd"
+	// Balanced disk type.
+	Balanced DiskType = "pd-balanced"
+	// HyperdiskBalanced disk type
+	HyperdiskBalanced DiskType = "hyperdisk-balanced"
+)
+
+// GCPManagedMachinePoolSpec defines the desired state of GCPManagedMachinePool.
+type GCPManagedMachinePoolSpec struct {
+	// NodePoolName specifies the name of the GKE node pool corresponding to this MachinePool. If you don't specify a name
+	// then a default name will be created based on the namespace and name of the managed machine pool.
+	// +optional
+	NodePoolName string `json:"nodePoolName,omitempty"`
+	// MachineType is the name of a Google Compute Engine [machine
+	// type](https://cloud.google.com/compute/docs/machine-types).
+	// If unspecified, the default machine type is `e2-medium`.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+	// LocalSsdCount is the number of local SSD disks to be attached to the node.
+	// +optional
+	LocalSsdCount *int32 `json:"localSsdCount,omitempty"`
+	// ReplicasPerZone is the number of nodes in each zone of the node pool. When set, the replicas of the MachinePool
+	// must be ReplicasPerZone times the number of zones, otherwise they must be a multiple of the number of zones in
+	// regional clusters.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	ReplicasPerZone *int32 `json:"replicasPerZone,omitempty"`
+	// Scaling specifies scaling for the node pool
+	// +optional
+	Scaling *NodePoolAutoScaling `json:"scaling,omitempty"`
+	// NodeLocations is the list of zones in which the NodePool's
+	// nodes should be located.
+	// +optional
+	NodeLocations []string `json:"nodeLocations,omitempty"`
+	// ImageType is image type to use for this nodepool.
+	// +optional
+	ImageType *string `json:"imageType,omitempty"`
+	// DiskType is type of the disk attached to each node.
+	// +optional
+	DiskType *DiskType `json:"diskType,omitempty"`
+	// DiskSizeGB is the size of the disk attached to each node, specified in GB.
+	// The smallest allowed disk size is 10GB. If unspecified, the default disk size is 100GB.
+	// +kubebuilder:validation:Minimum:=10
+	// +optional
+	DiskSizeGB *int64 `json:"diskSizeGB,omitempty"`
+	// MaxPodsPerNode is constraint enforced on the max num of
+	// pods per node.
+	// +kubebuilder:validation:Minimum:=8
+	// +kubebuilder:validation:Maximum:=256
+	// +optional
+	MaxPodsPerNode *int64 `json:"maxPodsPerNode,omitempty"`
+	// NodeNetwork specifies the node network configuration
+	// options.
+	// +optional
+	NodeNetwork NodeNetworkConfig `json:"nodeNetwork,omitempty"`
+	// NodeSecurity specifies the node security options.
+	// +optional
+	NodeSecurity NodeSecurityConfig `json:"nodeSecurity,omitempty"`
+	// KubernetesLabels specifies the labels to apply to the nodes of the node pool.
+	// +optional
+	KubernetesLabels infrav1.Labels `json:"kubernetesLabels,omitempty"`
+	// KubernetesTaints specifies the taints to apply to the nodes of the node pool.
+	// +optional
+	KubernetesTaints Taints `json:"kubernetesTaints,omitempty"`
+	// AdditionalLabels is an optional set of tags to add to GCP resources managed by the GCP provider, in addition to the
+	// ones added by default.
+	// +optional
+	AdditionalLabels infrav1.Labels `json:"additionalLabels,omitempty"`
+	// Management specifies the node pool management options.
+	// +optional
+	Management *NodePoolManagement `json:"management,omitempty"`
+	// LinuxNodeConfig specifies the settings for Linux agent nodes.
+	// +optional
+	LinuxNodeConfig *LinuxNodeConfig `json:"linuxNodeConfig,omitempty"`
+	// ProvisioningModel specifies whether the nodes are created as Spot or preemptible VMs.
+	// When unspecified, defaults to "Standard".
+	// +kubebuilder:validation:Enum=Standard;Spot;Preemptible
+	// +optional
+	ProvisioningModel *infrav1.ProvisioningModel `json:"provisioningModel,omitempty"`
+	// Accelerators is the list of hardware accelerators attached to each node.
+	// +optional
+	Accelerators []AcceleratorConfig `json:"accelerators,omitempty"`
+	// ReservationAffinity specifies the Compute Engine reservations the nodes can consume.
+	// +optional
+	ReservationAffinity *ReservationAffinity `json:"reservationAffinity,omitempty"`
+	// BootDiskKMSKey is the Cloud KMS key used to encrypt the boot disk of each node, in the form
+	// projects/[KEY_PROJECT_ID]/locations/[LOCATION]/keyRings/[RING_NAME]/cryptoKeys/[KEY_NAME].
+	// +optional
+	BootDiskKMSKey *string `json:"bootDiskKMSKey,omitempty"`
+	// KubeletConfig specifies the kubelet settings of the nodes. Settings can't be removed once set.
+	// +optional
+	KubeletConfig *KubeletConfig `json:"kubeletConfig,omitempty"`
+	// Metadata is the Compute Engine instance metadata set on each node.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// WorkloadMetadataMode specifies how the metadata server is exposed to the workloads running on the nodes.
+	// GKEMetadata requires Workload Identity to be enabled on the cluster. It can't be removed once set.
+	// +optional
+	WorkloadMetadataMode *WorkloadMetadataMode `json:"workloadMetadataMode,omitempty"`
+	// EnableGvnic specifies whether the nodes use Google Virtual NIC. It can't be removed once set.
+	// +optional
+	EnableGvnic *bool `json:"enableGvnic,omitempty"`
+	// EnableConfidentialNodes specifies whether the nodes are Confidential VMs. It can't be removed once set.
+	// +optional
+	EnableConfidentialNodes *bool `json:"enableConfidentialNodes,omitempty"`
+	// ResourceManagerTags are the resource manager tags bound to the nodes. Keys are in the form tagKeys/{tag_key_id}
+	// and values in the form tagValues/{tag_value_id}.
+	// +optional
+	ResourceManagerTags map[string]string `json:"resourceManagerTags,omitempty"`
+	// UpgradeSettings specifies the strategy used to upgrade the nodes of the node pool.
+	// +optional
+	UpgradeSettings *NodePoolUpgradeSettings `json:"upgradeSettings,omitempty"`
+	// Import adopts an existing node pool named NodePoolName instead of failing when one exists. Unset scaling,
+	// labels, taints and node locations are populated from the live node pool when it is adopted. The replicas of
+	// the MachinePool must match the node pool unless it is autoscaled.
+	// +optional
+	Import bool `json:"import,omitempty"`
+	// AllowUnownedDeletion allows deleting the node pool when it was not created by Cluster API, such as an
+	// imported node pool. Otherwise the node pool is left in place when the GCPManagedMachinePool is deleted.
+	// +optional
+	AllowUnownedDeletion bool `json:"allowUnownedDeletion,omitempty"`
+	// ProviderIDList are the provider IDs of instances in the
+	// managed instance group corresponding to the nodegroup represented by this
+	// machine pool
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+}
+
+// NodeNetworkConfig encapsulates node network configurations.
+type NodeNetworkConfig struct {
+	// Tags is list of instance tags applied to all nodes. Tags
+	// are used to identify valid sources or targets for network
+	// firewalls.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+	// CreatePodRange specifies whether to create a new range for
+	// pod IPs in this node pool.
+	// +optional
+	CreatePodRange *bool `json:"createPodRange,omitempty"`
+	// PodRangeName is ID of the secondary range for pod IPs.
+	// +optional
+	PodRangeName *string `json:"podRangeName,omitempty"`
+	// PodRangeCidrBlock is the IP address range for pod IPs in
+	// this node pool.
+	// +optional
+	PodRangeCidrBlock *string `json:"podRangeCidrBlock,omitempty"`
+}
+
+// NodeSecurityConfig encapsulates node security configurations.
+type NodeSecurityConfig struct {
+	// ServiceAccount specifies the identity details for node
+	// pool.
+	// +optional
+	ServiceAccount ServiceAccountConfig `json:"serviceAccount,omitempty"`
+	// SandboxType is type of the sandbox to use for the node.
+	// +optional
+	SandboxType *string `json:"sandboxType,omitempty"`
+	// EnableSecureBoot defines whether the instance has Secure
+	// Boot enabled.
+	// +optional
+	EnableSecureBoot *bool `json:"enableSecureBoot,omitempty"`
+	// EnableIntegrityMonitoring defines whether the instance has
+	// integrity monitoring enabled.
+	// +optional
+	EnableIntegrityMonitoring *bool `json:"enableIntegrityMonitoring,omitempty"`
+}
+
+// ServiceAccountConfig encapsulates service account options.
+type ServiceAccountConfig struct {
+	// Email is the Google Cloud Platform Service Account to be
+	// used by the node VMs.
+	// +optional
+	Email *string `json:"email,omitempty"`
+	// Scopes is a set of Google API scopes to be made available
+	// on all of the node VMs under the "default" service account.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// AcceleratorConfig specifies a hardware accelerator attached to the nodes.
+type AcceleratorConfig struct {
+	// Type is the accelerator type, for example nvidia-tesla-t4.
+	Type string `json:"type"`
+	// Count is the number of accelerators attached to each node.
+	// +kubebuilder:validation:Minimum:=1
+	Count int64 `json:"count"`
+	// GPUPartitionSize is the size of the partitions to create on a multi-instance GPU, for example 1g.5gb.
+	// +optional
+	GPUPartitionSize *string `json:"gpuPartitionSize,omitempty"`
+	// GPUDriverVersion specifies the NVIDIA driver version GKE installs on the nodes. If unspecified, the
+	// GKE default for the node version applies.
+	// +kubebuilder:validation:Enum=Default;Latest;InstallationDisabled
+	// +optional
+	GPUDriverVersion *GPUDriverVersion `json:"gpuDriverVersion,omitempty"`
+}
+
+// GPUDriverVersion is the NVIDIA driver version installed on GPU nodes.
+type GPUDriverVersion string
+
+const (
+	// GPUDriverVersionDefault installs the default driver version for the node version.
+	GPUDriverVersionDefault GPUDriverVersion = "Default"
+	// GPUDriverVersionLatest installs the latest driver version available for the node version.
+	GPUDriverVersionLatest GPUDriverVersion = "Latest"
+	// GPUDriverInstallationDisabled disables driver installation, drivers must be installed manually.
+	GPUDriverInstallationDisabled GPUDriverVersion = "InstallationDisabled"
+)
+
+// ReservationAffinity specifies the Compute Engine reservations the nodes can consume.
+type ReservationAffinity struct {
+	// ConsumeReservationType specifies which reservations the nodes can take capacity from.
+	// +kubebuilder:validation:Enum=NoReservation;AnyReservation;SpecificReservation
+	ConsumeReservationType ReservationAffinityType `json:"consumeReservationType"`
+	// Key is the label key of the reservation resource. Use compute.googleapis.com/reservation-name to
+	// select a reservation by name. Required for SpecificReservation.
+	// +optional
+	Key string `json:"key,omitempty"`
+	// Values are the label values of the reservation resource. Required for SpecificReservation.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// ReservationAffinityType is the type of reservation consumption.
+type ReservationAffinityType string
+
+const (
+	// NoReservation does not consume any reservation.
+	NoReservation ReservationAffinityType = "NoReservation"
+	// AnyReservation consumes any matching reservation.
+	AnyReservation ReservationAffinityType = "AnyReservation"
+	// SpecificReservation consumes only the reservation selected by key and values.
+	SpecificReservation ReservationAffinityType = "SpecificReservation"
+)
+
+// KubeletConfig specifies the kubelet settings of the nodes.
+type KubeletConfig struct {
+	// CPUManagerPolicy is the CPU management policy of the kubelet.
+	// +kubebuilder:validation:Enum=none;static
+	// +optional
+	CPUManagerPolicy *string `json:"cpuManagerPolicy,omitempty"`
+	// CPUCFSQuota enables CPU CFS quota enforcement for containers that specify CPU limits.
+	// +optional
+	CPUCFSQuota *bool `json:"cpuCFSQuota,omitempty"`
+	// CPUCFSQuotaPeriod is the CPU CFS quota period, between 1ms and 1s, for example 100ms.
+	// +optional
+	CPUCFSQuotaPeriod *string `json:"cpuCFSQuotaPeriod,omitempty"`
+	// PodPidsLimit is the maximum number of process IDs per pod.
+	// +kubebuilder:validation:Minimum:=1024
+	// +kubebuilder:validation:Maximum:=4194304
+	// +optional
+	PodPidsLimit *int64 `json:"podPidsLimit,omitempty"`
+}
+
+// WorkloadMetadataMode specifies how the metadata server is exposed to workloads.
+// +kubebuilder:validation:Enum=GCEMetadata;GKEMetadata
+type WorkloadMetadataMode string
+
+const (
+	// GCEMetadata exposes the Compute Engine metadata server to workloads.
+	GCEMetadata WorkloadMetadataMode = "GCEMetadata"
+	// GKEMetadata runs the GKE metadata server, which exposes Workload Identity credentials to workloads.
+	GKEMetadata WorkloadMetadataMode = "GKEMetadata"
+)
+
+// NodePoolUpgradeStrategy is the strategy used to upgrade the nodes of a node pool.
+type NodePoolUpgradeStrategy string
+
+const (
+	// SurgeUpgradeStrategy upgrades the nodes in place, a few at a time, by creating surge nodes.
+	SurgeUpgradeStrategy NodePoolUpgradeStrategy = "Surge"
+	// BlueGreenUpgradeStrategy creates a new set of nodes and drains the old ones in batches.
+	BlueGreenUpgradeStrategy NodePoolUpgradeStrategy = "BlueGreen"
+)
+
+// NodePoolUpgradeSettings specifies the strategy used to upgrade the nodes of a node pool.
+type NodePoolUpgradeSettings struct {
+	// Strategy is the upgrade strategy of the node pool.
+	// +kubebuilder:validation:Enum=Surge;BlueGreen
+	// +kubebuilder:default=Surge
+	// +optional
+	Strategy NodePoolUpgradeStrategy `json:"strategy,omitempty"`
+	// Surge configures the surge upgrade strategy.
+	// +optional
+	Surge *SurgeUpgradeSettings `json:"surge,omitempty"`
+	// BlueGreen configures the blue-green upgrade strategy.
+	// +optional
+	BlueGreen *BlueGreenUpgradeSettings `json:"blueGreen,omitempty"`
+}
+
+// SurgeUpgradeSettings configures the surge upgrade strategy.
+type SurgeUpgradeSettings struct {
+	// MaxSurge is the maximum number of nodes that can be created beyond the size of the node pool during the upgrade.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxSurge int32 `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of nodes that can be unavailable at the same time during the upgrade.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxUnavailable int32 `json:"maxUnavailable,omitempty"`
+}
+
+// BlueGreenUpgradeSettings configures the blue-green upgrade strategy.
+type BlueGreenUpgradeSettings struct {
+	// BatchNodeCount is the number of blue nodes drained in a batch.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	BatchNodeCount *int32 `json:"batchNodeCount,omitempty"`
+	// BatchPercentage is the percentage of blue nodes drained in a batch.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// +optional
+	BatchPercentage *int32 `json:"batchPercentage,omitempty"`
+	// BatchSoakDuration is the time to wait after draining a batch before draining the next one.
+	// +optional
+	BatchSoakDuration *metav1.Duration `json:"batchSoakDuration,omitempty"`
+	// NodePoolSoakDuration is the time to wait after all the blue nodes are drained before deleting them.
+	// +optional
+	NodePoolSoakDuration *metav1.Duration `json:"nodePoolSoakDuration,omitempty"`
+}
+
+// NodePoolZoneStatus reports the nodes of a node pool in a zone.
+type NodePoolZoneStatus struct {
+	// Zone is the name of the zone.
+	Zone string `json:"zone"`
+	// Replicas is the number of instances in the zone.
+	Replicas int32 `json:"replicas"`
+	// ReadyReplicas is the number of instances in the zone that are running with no pending action.
+	ReadyReplicas int32 `json:"readyReplicas"`
+}
+
+// NodePoolUpgradeStatus reports the progress of a node pool upgrade.
+type NodePoolUpgradeStatus struct {
+	// Strategy is the upgrade strategy in use.
+	// +optional
+	Strategy NodePoolUpgradeStrategy `json:"strategy,omitempty"`
+	// Operation is the name of the GKE operation running the upgrade.
+	// +optional
+	Operation string `json:"operation,omitempty"`
+	// TargetVersion is the Kubernetes version the nodes are upgraded to.
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// Phase is the phase of a blue-green upgrade as reported by GKE, for example DRAINING_BLUE_POOL.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// CurrentBatch is the batch of nodes being upgraded, starting at 1.
+	// +optional
+	CurrentBatch int32 `json:"currentBatch,omitempty"`
+	// NodesUpgraded is the number of nodes already upgraded.
+	// +optional
+	NodesUpgraded int64 `json:"nodesUpgraded,omitempty"`
+	// NodesTotal is the number of nodes to upgrade.
+	// +optional
+	NodesTotal int64 `json:"nodesTotal,omitempty"`
+	// StartTime is the time the upgrade was requested.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// GCPManagedMachinePoolStatus defines the observed state of GCPManagedMachinePool.
+type GCPManagedMachinePoolStatus struct {
+	// Ready denotes that the GCPManagedMachinePool has joined the cluster
+	// +kubebuilder:default=false
+	Ready bool `json:"ready"`
+	// Replicas is the most recently observed number of replicas.
+	// +optional
+	Replicas int32 `json:"replicas"`
+	// Zones reports the number of nodes in each zone of the node pool.
+	// +optional
+	Zones []NodePoolZoneStatus `json:"zones,omitempty"`
+	// Upgrade reports the progress of the node pool upgrade in progress, if any.
+	// +optional
+	Upgrade *NodePoolUpgradeStatus `json:"upgrade,omitempty"`
+	// Imported is true once an existing node pool has been adopted and the spec populated from it.
+	// +optional
+	Imported bool `json:"imported,omitempty"`
+	// Operation is the last GKE operation started on the node pool.
+	// +optional
+	Operation *GKEOperation `json:"operation,omitempty"`
+	// GKEConditions are the conditions reported by GKE on the node pool, which explain an error or degraded state.
+	// +optional
+	GKEConditions []GKEStatusCondition `json:"gkeConditions,omitempty"`
+	// Conditions specifies the cpnditions for the managed machine pool
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready"
+// +kubebuilder:printcolumn:name="Replicas",type="string",JSONPath=".status.replicas"
+// +kubebuilder:resource:path=gcpmanagedmachinepools,scope=Namespaced,categories=cluster-api,shortName=gcpmmp
+
+// GCPManagedMachinePool is the Schema for the gcpmanagedmachinepools API.
+type GCPManagedMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPManagedMachinePoolSpec   `json:"spec,omitempty"`
+	Status GCPManagedMachinePoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// GCPManagedMachinePoolList contains a list of GCPManagedMachinePool.
+type GCPManagedMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPManagedMachinePool `json:"items"`
+}
+
+// NodePoolAutoScaling specifies scaling options.
+type NodePoolAutoScaling struct {
+	// MinCount specifies the minimum number of nodes in the node pool
+	// +optional
+	MinCount *int32 `json:"minCount,omitempty"`
+	// MaxCount specifies the maximum number of nodes in the node pool
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+	// Is autoscaling enabled for this node pool. If unspecified, the default value is true.
+	// +optional
+	EnableAutoscaling *bool `json:"enableAutoscaling,omitempty"`
+	// Location policy used when scaling up a nodepool.
+	// +kubebuilder:validation:Enum=balanced;any
+	// +optional
+	LocationPolicy *ManagedNodePoolLocationPolicy `json:"locationPolicy,omitempty"`
+}
+
+// NodePoolManagement specifies auto-upgrade and auto-repair options.
+type NodePoolManagement struct {
+	// AutoUpgrade specifies whether node auto-upgrade is enabled for the node
+	// pool. If enabled, node auto-upgrade helps keep the nodes in your node pool
+	// up to date with the latest release version of Kubernetes.
+	AutoUpgrade bool `json:"autoUpgrade,omitempty"`
+	// AutoRepair specifies whether the node auto-repair is enabled for the node
+	// pool. If enabled, the nodes in this node pool will be monitored and, if
+	// they fail health checks too many times, an automatic repair action will be
+	// triggered.
+	AutoRepair bool `json:"autoRepair,omitempty"`
+}
+
+// ManagedNodePoolLocationPolicy specifies the location policy of the node pool when autoscaling is enabled.
+type ManagedNodePoolLocationPolicy string
+
+// LinuxNodeConfig specifies the settings for Linux agent nodes.
+type LinuxNodeConfig struct {
+	// Sysctls specifies the sysctl settings for this node pool.
+	// +optional
+	Sysctls []SysctlConfig `json:"sysctls,omitempty"`
+	// CgroupMode specifies the cgroup mode for this node pool.
+	// +optional
+	CgroupMode *ManagedNodePoolCgroupMode `json:"cgroupMode,omitempty"`
+}
+
+// SysctlConfig specifies the sysctl settings for Linux nodes.
+type SysctlConfig struct {
+	// Parameter specifies sysctl parameter name.
+	// +optional
+	Parameter string `json:"parameter,omitempty"`
+	// Value specifies sysctl parameter value.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// ManagedNodePoolCgroupMode specifies the cgroup mode of the node pool when autoscaling is enabled.
+type ManagedNodePoolCgroupMode int32
+
+const (
+	// ManagedNodePoolLocationPolicyBalanced aims to balance the sizes of different zones.
+	ManagedNodePoolLocationPolicyBalanced ManagedNodePoolLocationPolicy = "balanced"
+	// ManagedNodePoolLocationPolicyAny picks zones that have the highest capacity available.
+	ManagedNodePoolLocationPolicyAny ManagedNodePoolLocationPolicy = "any"
+)
+
+// GetConditions returns the machine pool conditions.
+func (r *GCPManagedMachinePool) GetConditions() clusterv1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the status conditions for the GCPManagedMachinePool.
+func (r *GCPManagedMachinePool) SetConditions(conditions clusterv1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&GCPManagedMachinePool{}, &GCPManagedMachinePoolList{})
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TaintEffect is the effect for a Kubernetes taint.
+type TaintEffect string
+
+// Taint represents a Kubernetes taint.
+type Taint struct {
+	// Effect specifies the effect for the taint.
+	// +kubebuilder:validation:Enum=NoSchedule;NoExecute;PreferNoSchedule
+	Effect TaintEffect `json:"effect"`
+	// Key is the key of the taint
+	Key string `json:"key"`
+	// Value is the value of the taint
+	Value string `json:"value"`
+}
+
+// Taints is an array of Taints.
+type Taints []Taint
+
+// GKEOperation reports a GKE operation started on a cluster or node pool.
+type GKEOperation struct {
+	// Name is the name of the operation.
+	Name string `json:"name"`
+	// Type is the type of the operation, for example CREATE_CLUSTER or UPGRADE_NODES.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Status is the status of the operation, for example RUNNING or DONE.
+	// +optional
+	Status string `json:"status,omitempty"`
+	// Progress is the completion percentage of the operation, when reported by GKE.
+	// +optional
+	Progress int32 `json:"progress,omitempty"`
+	// StartTime is the time the operation started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time the operation completed.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// Error is the error message of a failed operation.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// GKEStatusCondition is a condition reported by GKE on a cluster or node pool.
+type GKEStatusCondition struct {
+	// Code is the canonical code of the condition, for example RESOURCE_EXHAUSTED.
+	// +optional
+	Code string `json:"code,omitempty"`
+	// Message is the message of the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}